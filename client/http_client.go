@@ -68,7 +68,7 @@ func NewHttpClient(username, password, apiUrl, templatePath string, useHttps boo
 func (slc *HttpClient) DoRawHttpRequestWithObjectMask(path string, masks []string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
 	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
 
-	url += "?objectMask="
+	url += querySeparator(path) + "objectMask="
 	for i := 0; i < len(masks); i++ {
 		url += masks[i]
 		if i != len(masks)-1 {
@@ -81,7 +81,7 @@ func (slc *HttpClient) DoRawHttpRequestWithObjectMask(path string, masks []strin
 
 func (slc *HttpClient) DoRawHttpRequestWithObjectFilter(path string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
 	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
-	url += "?objectFilter=" + filters
+	url += querySeparator(path) + "objectFilter=" + filters
 
 	return slc.makeHttpRequest(url, requestType, requestBody)
 }
@@ -89,7 +89,7 @@ func (slc *HttpClient) DoRawHttpRequestWithObjectFilter(path string, filters str
 func (slc *HttpClient) DoRawHttpRequestWithObjectFilterAndObjectMask(path string, masks []string, filters string, requestType string, requestBody *bytes.Buffer) ([]byte, int, error) {
 	url := fmt.Sprintf("%s://%s:%s@%s/%s", slc.scheme(), slc.username, slc.password, slc.apiUrl, path)
 
-	url += "?objectFilter=" + filters
+	url += querySeparator(path) + "objectFilter=" + filters
 
 	url += "&objectMask=filteredMask["
 	for i := 0; i < len(masks); i++ {
@@ -252,3 +252,11 @@ func checkNonVerbose() bool {
 
 	return false
 }
+
+func querySeparator(path string) string {
+	if strings.Contains(path, "?") {
+		return "&"
+	}
+
+	return "?"
+}
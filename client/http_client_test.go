@@ -68,6 +68,24 @@ var _ = Describe("A HTTP Client", func() {
 				})
 			})
 		})
+
+		Context("#DoRawHttpRequestWithObjectFilterAndObjectMask", func() {
+			Context("when the path already has a query", func() {
+				BeforeEach(func() {
+					server.CloseClientConnections()
+					server.AppendHandlers(
+						ghttp.VerifyRequest("GET", "/test", `resultLimit=0,50&objectFilter={"id":{"operation":1}}&objectMask=filteredMask[id]`),
+						ghttp.VerifyBasicAuth(slUsername, slAPIKey),
+					)
+				})
+
+				It("appends the filter and mask to the query", func() {
+					_, _, err = client.DoRawHttpRequestWithObjectFilterAndObjectMask("test?resultLimit=0,50", []string{"id"}, `{"id":{"operation":1}}`, "GET", new(bytes.Buffer))
+					Ω(err).ShouldNot(HaveOccurred())
+					Ω(server.ReceivedRequests()).Should(HaveLen(1))
+				})
+			})
+		})
 	})
 
 	Context("when the target HTTP server is not stable", func() {
@@ -174,6 +174,213 @@ func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_Service() (so
 	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Service), nil
 }
 
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_AType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_AType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_AaaaType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_AaaaType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_CnameType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_CnameType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_MxType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_MxType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_NsType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_NsType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_PtrType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_PtrType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_SpfType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_SpfType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_TxtType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_TxtType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Domain_ResourceRecord_SrvType_Service() (softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Domain_ResourceRecord_SrvType")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Component_Service() (softlayer.SoftLayer_Network_Component_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Component")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Component_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Vlan_Service() (softlayer.SoftLayer_Network_Vlan_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Vlan")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Vlan_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Subnet_Service() (softlayer.SoftLayer_Network_Subnet_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Subnet")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Subnet_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Subnet_IpAddress_Service() (softlayer.SoftLayer_Network_Subnet_IpAddress_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Subnet_IpAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Subnet_IpAddress_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Subnet_IpAddress_Global_Service() (softlayer.SoftLayer_Network_Subnet_IpAddress_Global_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Subnet_IpAddress_Global")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Subnet_IpAddress_Global_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_SecurityGroup_Service() (softlayer.SoftLayer_Network_SecurityGroup_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_SecurityGroup")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_SecurityGroup_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Firewall_Update_Request_Service() (softlayer.SoftLayer_Network_Firewall_Update_Request_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Firewall_Update_Request")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Firewall_Update_Request_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Component_Firewall_Service() (softlayer.SoftLayer_Network_Component_Firewall_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Component_Firewall")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Component_Firewall_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Vlan_Firewall_Service() (softlayer.SoftLayer_Network_Vlan_Firewall_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Vlan_Firewall")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Vlan_Firewall_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service() (softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service() (softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service() (softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Network_LoadBalancer_Global_Account_Service() (softlayer.SoftLayer_Network_LoadBalancer_Global_Account_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Network_LoadBalancer_Global_Account")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Network_LoadBalancer_Global_Account_Service), nil
+}
+
+func (slc *SoftLayerClient) GetSoftLayer_Dns_Secondary_Service() (softlayer.SoftLayer_Dns_Secondary_Service, error) {
+	slService, err := slc.GetService("SoftLayer_Dns_Secondary")
+	if err != nil {
+		return nil, err
+	}
+
+	return slService.(softlayer.SoftLayer_Dns_Secondary_Service), nil
+}
+
 func GetSLApiEndpoint() string {
 	sl_api_endpoint := os.Getenv("SL_API_ENDPOINT")
 	var included bool = false
@@ -209,4 +416,22 @@ func (slc *SoftLayerClient) initSoftLayerServices() {
 	slc.softLayerServices["SoftLayer_Hardware"] = services.NewSoftLayer_Hardware_Service(slc)
 	slc.softLayerServices["SoftLayer_Dns_Domain"] = services.NewSoftLayer_Dns_Domain_Service(slc)
 	slc.softLayerServices["SoftLayer_Dns_Domain_ResourceRecord"] = services.NewSoftLayer_Dns_Domain_ResourceRecord_Service(slc)
+	for _, recordType := range services.DNS_RESOURCE_RECORD_TYPES {
+		recordTypeService, _ := services.NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service(slc, recordType)
+		slc.softLayerServices[recordTypeService.GetName()] = recordTypeService
+	}
+	slc.softLayerServices["SoftLayer_Network_Component"] = services.NewSoftLayer_Network_Component_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Vlan"] = services.NewSoftLayer_Network_Vlan_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Subnet"] = services.NewSoftLayer_Network_Subnet_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Subnet_IpAddress"] = services.NewSoftLayer_Network_Subnet_IpAddress_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Subnet_IpAddress_Global"] = services.NewSoftLayer_Network_Subnet_IpAddress_Global_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_SecurityGroup"] = services.NewSoftLayer_Network_SecurityGroup_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Firewall_Update_Request"] = services.NewSoftLayer_Network_Firewall_Update_Request_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Component_Firewall"] = services.NewSoftLayer_Network_Component_Firewall_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Vlan_Firewall"] = services.NewSoftLayer_Network_Vlan_Firewall_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress"] = services.NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group"] = services.NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service"] = services.NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service(slc)
+	slc.softLayerServices["SoftLayer_Network_LoadBalancer_Global_Account"] = services.NewSoftLayer_Network_LoadBalancer_Global_Account_Service(slc)
+	slc.softLayerServices["SoftLayer_Dns_Secondary"] = services.NewSoftLayer_Dns_Secondary_Service(slc)
 }
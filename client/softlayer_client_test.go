@@ -188,6 +188,222 @@ var _ = Describe("SoftLayerClient", func() {
 		})
 	})
 
+	Context("#GetSoftLayer_Network_Component_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Component_Service interface", func() {
+			var networkComponentService softlayer.SoftLayer_Network_Component_Service
+			networkComponentService, err := client.GetSoftLayer_Network_Component_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkComponentService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Vlan_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Vlan_Service interface", func() {
+			var networkVlanService softlayer.SoftLayer_Network_Vlan_Service
+			networkVlanService, err := client.GetSoftLayer_Network_Vlan_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkVlanService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Subnet_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Subnet_Service interface", func() {
+			var networkSubnetService softlayer.SoftLayer_Network_Subnet_Service
+			networkSubnetService, err := client.GetSoftLayer_Network_Subnet_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkSubnetService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Subnet_IpAddress_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Subnet_IpAddress_Service interface", func() {
+			var networkSubnetIpAddressService softlayer.SoftLayer_Network_Subnet_IpAddress_Service
+			networkSubnetIpAddressService, err := client.GetSoftLayer_Network_Subnet_IpAddress_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkSubnetIpAddressService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Subnet_IpAddress_Global_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Subnet_IpAddress_Global_Service interface", func() {
+			var networkSubnetIpAddressGlobalService softlayer.SoftLayer_Network_Subnet_IpAddress_Global_Service
+			networkSubnetIpAddressGlobalService, err := client.GetSoftLayer_Network_Subnet_IpAddress_Global_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkSubnetIpAddressGlobalService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_SecurityGroup_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_SecurityGroup_Service interface", func() {
+			var networkSecurityGroupService softlayer.SoftLayer_Network_SecurityGroup_Service
+			networkSecurityGroupService, err := client.GetSoftLayer_Network_SecurityGroup_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkSecurityGroupService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Firewall_Update_Request_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Firewall_Update_Request_Service interface", func() {
+			var networkFirewallUpdateRequestService softlayer.SoftLayer_Network_Firewall_Update_Request_Service
+			networkFirewallUpdateRequestService, err := client.GetSoftLayer_Network_Firewall_Update_Request_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkFirewallUpdateRequestService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Component_Firewall_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Component_Firewall_Service interface", func() {
+			var networkComponentFirewallService softlayer.SoftLayer_Network_Component_Firewall_Service
+			networkComponentFirewallService, err := client.GetSoftLayer_Network_Component_Firewall_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkComponentFirewallService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Vlan_Firewall_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Vlan_Firewall_Service interface", func() {
+			var networkVlanFirewallService softlayer.SoftLayer_Network_Vlan_Firewall_Service
+			networkVlanFirewallService, err := client.GetSoftLayer_Network_Vlan_Firewall_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkVlanFirewallService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service interface", func() {
+			var loadBalancerVirtualIpAddressService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service
+			loadBalancerVirtualIpAddressService, err := client.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loadBalancerVirtualIpAddressService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service interface", func() {
+			var loadBalancerServiceGroupService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service
+			loadBalancerServiceGroupService, err := client.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loadBalancerServiceGroupService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service interface", func() {
+			var loadBalancerServiceService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service
+			loadBalancerServiceService, err := client.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(loadBalancerServiceService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Network_LoadBalancer_Global_Account_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Network_LoadBalancer_Global_Account_Service interface", func() {
+			var globalLoadBalancerAccountService softlayer.SoftLayer_Network_LoadBalancer_Global_Account_Service
+			globalLoadBalancerAccountService, err := client.GetSoftLayer_Network_LoadBalancer_Global_Account_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(globalLoadBalancerAccountService).ToNot(BeNil())
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_AType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_AType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("a"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_AaaaType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_AaaaType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("aaaa"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_CnameType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_CnameType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("cname"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_MxType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_MxType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("mx"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_NsType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_NsType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("ns"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_PtrType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_PtrType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("ptr"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_SpfType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_SpfType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("spf"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_TxtType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_TxtType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("txt"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Domain_ResourceRecord_SrvType_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface", func() {
+			var dnsResourceRecordTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+			dnsResourceRecordTypeService, err := client.GetSoftLayer_Dns_Domain_ResourceRecord_SrvType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsResourceRecordTypeService).ToNot(BeNil())
+			Expect(dnsResourceRecordTypeService.GetRecordType()).To(Equal("srv"))
+		})
+	})
+
+	Context("#GetSoftLayer_Dns_Secondary_Service", func() {
+		It("returns an instance implemementing the SoftLayer_Dns_Secondary_Service interface", func() {
+			var dnsSecondaryService softlayer.SoftLayer_Dns_Secondary_Service
+			dnsSecondaryService, err := client.GetSoftLayer_Dns_Secondary_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(dnsSecondaryService).ToNot(BeNil())
+		})
+	})
+
 	Context("#GetApiEndpoint", func() {
 		Context("#when SL_API_ENDPOINT is set correctly", func() {
 			It("returns the correct SL api endpoint url", func() {
@@ -0,0 +1,165 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"regexp"
+	"strings"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const (
+	DNS_RECORD_MIN_TTL = 60
+	DNS_RECORD_MAX_TTL = 604800
+)
+
+var (
+	dnsRecordLabelRegexp    = regexp.MustCompile(`^[A-Za-z0-9_]([A-Za-z0-9_-]{0,61}[A-Za-z0-9_])?$`)
+	dnsRecordServiceRegexp  = regexp.MustCompile(`^_[A-Za-z0-9][A-Za-z0-9-]*$`)
+	dnsRecordProtocolRegexp = regexp.MustCompile(`^_(tcp|udp|tls|sctp)$`)
+)
+
+func NewDnsARecord(domainId int, host string, ipAddress string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: ipAddress, Ttl: ttl, Type: "a"}
+}
+
+func NewDnsAaaaRecord(domainId int, host string, ipAddress string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: ipAddress, Ttl: ttl, Type: "aaaa"}
+}
+
+func NewDnsCnameRecord(domainId int, host string, target string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: qualifyZoneName(target), Ttl: ttl, Type: "cname"}
+}
+
+func NewDnsMxRecord(domainId int, host string, exchange string, priority int, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: qualifyZoneName(exchange), MxPriority: priority, Ttl: ttl, Type: "mx"}
+}
+
+func NewDnsNsRecord(domainId int, host string, nameServer string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: qualifyZoneName(nameServer), Ttl: ttl, Type: "ns"}
+}
+
+func NewDnsPtrRecord(domainId int, host string, target string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: qualifyZoneName(target), Ttl: ttl, Type: "ptr"}
+}
+
+func NewDnsSpfRecord(domainId int, host string, text string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: text, Ttl: ttl, Type: "spf"}
+}
+
+func NewDnsTxtRecord(domainId int, host string, text string, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: domainId, Host: host, Data: text, Ttl: ttl, Type: "txt"}
+}
+
+func NewDnsSrvRecord(domainId int, service string, protocol string, host string, target string, priority int, weight int, port int, ttl int) datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template {
+	return datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{
+		DomainId: domainId,
+		Service:  service,
+		Protocol: protocol,
+		Host:     host,
+		Data:     qualifyZoneName(target),
+		Priority: priority,
+		Weight:   weight,
+		Port:     port,
+		Ttl:      ttl,
+		Type:     "srv",
+	}
+}
+
+// ValidateDnsResourceRecord checks the fields SoftLayer requires for the type of the record, so that invalid
+// records are rejected before any API call is made.
+func ValidateDnsResourceRecord(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) error {
+	recordType := strings.ToLower(record.Type)
+
+	if record.Ttl < DNS_RECORD_MIN_TTL || record.Ttl > DNS_RECORD_MAX_TTL {
+		return errors.New(fmt.Sprintf("softlayer-go: TTL of %s record must be between %d and %d seconds, got '%d'", recordType, DNS_RECORD_MIN_TTL, DNS_RECORD_MAX_TTL, record.Ttl))
+	}
+
+	if strings.HasSuffix(record.Host, ".") {
+		return errors.New(fmt.Sprintf("softlayer-go: host '%s' of %s record must be relative to the domain, without a trailing dot", record.Host, recordType))
+	}
+
+	if record.Host != "@" && !isValidDnsRecordName(record.Host) {
+		return errors.New(fmt.Sprintf("softlayer-go: invalid host '%s' for %s record", record.Host, recordType))
+	}
+
+	switch recordType {
+	case "a":
+		ip := net.ParseIP(record.Data)
+		if ip == nil || ip.To4() == nil || strings.Contains(record.Data, ":") {
+			return errors.New(fmt.Sprintf("softlayer-go: a record expects an IPv4 address, got '%s'", record.Data))
+		}
+	case "aaaa":
+		ip := net.ParseIP(record.Data)
+		if ip == nil || !strings.Contains(record.Data, ":") {
+			return errors.New(fmt.Sprintf("softlayer-go: aaaa record expects an IPv6 address, got '%s'", record.Data))
+		}
+	case "cname", "ns", "ptr":
+		if recordType == "cname" && record.Host == "@" {
+			return errors.New("softlayer-go: cname record cannot be created for the zone apex '@'")
+		}
+
+		if !isValidDnsRecordName(record.Data) {
+			return errors.New(fmt.Sprintf("softlayer-go: %s record expects a host name, got '%s'", recordType, record.Data))
+		}
+	case "mx":
+		if record.MxPriority < 0 || record.MxPriority > 65535 {
+			return errors.New(fmt.Sprintf("softlayer-go: mx record priority must be between 0 and 65535, got '%d'", record.MxPriority))
+		}
+
+		if !isValidDnsRecordName(record.Data) {
+			return errors.New(fmt.Sprintf("softlayer-go: mx record expects a host name, got '%s'", record.Data))
+		}
+	case "txt", "spf":
+		if record.Data == "" {
+			return errors.New(fmt.Sprintf("softlayer-go: %s record requires data", recordType))
+		}
+
+		if recordType == "spf" && !strings.HasPrefix(record.Data, "v=spf1") {
+			return errors.New(fmt.Sprintf("softlayer-go: spf record must start with 'v=spf1', got '%s'", record.Data))
+		}
+	case "srv":
+		if !dnsRecordServiceRegexp.MatchString(record.Service) {
+			return errors.New(fmt.Sprintf("softlayer-go: srv record service must look like '_service', got '%s'", record.Service))
+		}
+
+		if !dnsRecordProtocolRegexp.MatchString(record.Protocol) {
+			return errors.New(fmt.Sprintf("softlayer-go: srv record protocol must be one of '_tcp', '_udp', '_tls' or '_sctp', got '%s'", record.Protocol))
+		}
+
+		if record.Priority < 0 || record.Priority > 65535 || record.Weight < 0 || record.Weight > 65535 {
+			return errors.New(fmt.Sprintf("softlayer-go: srv record priority and weight must be between 0 and 65535, got '%d' and '%d'", record.Priority, record.Weight))
+		}
+
+		if record.Port < 1 || record.Port > 65535 {
+			return errors.New(fmt.Sprintf("softlayer-go: srv record port must be between 1 and 65535, got '%d'", record.Port))
+		}
+
+		if !isValidDnsRecordName(record.Data) {
+			return errors.New(fmt.Sprintf("softlayer-go: srv record expects a target host name, got '%s'", record.Data))
+		}
+	default:
+		return errors.New(fmt.Sprintf("softlayer-go: unsupported DNS record type '%s'", record.Type))
+	}
+
+	return nil
+}
+
+//Private methods
+
+func isValidDnsRecordName(name string) bool {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return false
+	}
+
+	for _, label := range strings.Split(name, ".") {
+		if label != "*" && !dnsRecordLabelRegexp.MatchString(label) {
+			return false
+		}
+	}
+
+	return true
+}
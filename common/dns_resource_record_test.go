@@ -0,0 +1,121 @@
+package common_test
+
+import (
+	"strings"
+
+	. "github.com/maximilien/softlayer-go/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+var _ = Describe("DNS resource records", func() {
+	Context("constructors", func() {
+		It("fills in the type of the record", func() {
+			Expect(NewDnsARecord(1234567, "www", "169.45.2.20", 3600)).To(Equal(datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{DomainId: 1234567, Host: "www", Data: "169.45.2.20", Ttl: 3600, Type: "a"}))
+			Expect(NewDnsAaaaRecord(1234567, "www", "2607:f0d0:1002:51::4", 3600).Type).To(Equal("aaaa"))
+			Expect(NewDnsSpfRecord(1234567, "@", "v=spf1 -all", 3600).Type).To(Equal("spf"))
+			Expect(NewDnsTxtRecord(1234567, "@", "hello", 3600).Type).To(Equal("txt"))
+		})
+
+		It("adds the trailing dot to fully qualified targets", func() {
+			Expect(NewDnsCnameRecord(1234567, "ftp", "www.example.com", 3600).Data).To(Equal("www.example.com."))
+			Expect(NewDnsNsRecord(1234567, "@", "ns1.softlayer.com.", 86400).Data).To(Equal("ns1.softlayer.com."))
+			Expect(NewDnsPtrRecord(1234567, "20", "www.example.com", 3600).Data).To(Equal("www.example.com."))
+			Expect(NewDnsCnameRecord(1234567, "ftp", "www", 3600).Data).To(Equal("www"))
+
+			mx := NewDnsMxRecord(1234567, "@", "mail.example.com", 10, 3600)
+			Expect(mx.Data).To(Equal("mail.example.com."))
+			Expect(mx.MxPriority).To(Equal(10))
+		})
+
+		It("sets the service fields of SRV records", func() {
+			Expect(NewDnsSrvRecord(1234567, "_sip", "_tcp", "voip", "sip.example.com", 10, 60, 5060, 7200)).To(Equal(datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{
+				DomainId: 1234567,
+				Service:  "_sip",
+				Protocol: "_tcp",
+				Host:     "voip",
+				Data:     "sip.example.com.",
+				Priority: 10,
+				Weight:   60,
+				Port:     5060,
+				Ttl:      7200,
+				Type:     "srv",
+			}))
+		})
+	})
+
+	Context("#ValidateDnsResourceRecord", func() {
+		var record datatypes.SoftLayer_Dns_Domain_ResourceRecord
+
+		validate := func(template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) error {
+			return ValidateDnsResourceRecord(datatypes.SoftLayer_Dns_Domain_ResourceRecord(template))
+		}
+
+		It("accepts valid records of every type", func() {
+			Expect(validate(NewDnsARecord(1, "www", "169.45.2.20", 3600))).To(Succeed())
+			Expect(validate(NewDnsAaaaRecord(1, "www", "2607:f0d0:1002:51::4", 3600))).To(Succeed())
+			Expect(validate(NewDnsCnameRecord(1, "ftp", "www.example.com", 3600))).To(Succeed())
+			Expect(validate(NewDnsMxRecord(1, "@", "mail.example.com", 10, 3600))).To(Succeed())
+			Expect(validate(NewDnsNsRecord(1, "dev", "ns1.softlayer.com", 86400))).To(Succeed())
+			Expect(validate(NewDnsPtrRecord(1, "20", "www.example.com", 3600))).To(Succeed())
+			Expect(validate(NewDnsSpfRecord(1, "@", "v=spf1 -all", 3600))).To(Succeed())
+			Expect(validate(NewDnsTxtRecord(1, "*.dev", "hello", 3600))).To(Succeed())
+			Expect(validate(NewDnsSrvRecord(1, "_sip", "_tcp", "@", "sip.example.com", 0, 0, 5060, 3600))).To(Succeed())
+		})
+
+		It("fails for TTLs out of range", func() {
+			Expect(validate(NewDnsARecord(1, "www", "169.45.2.20", 59))).ToNot(Succeed())
+			Expect(validate(NewDnsARecord(1, "www", "169.45.2.20", DNS_RECORD_MAX_TTL+1))).ToNot(Succeed())
+		})
+
+		It("fails for invalid hosts", func() {
+			Expect(validate(NewDnsARecord(1, "", "169.45.2.20", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsARecord(1, "my host", "169.45.2.20", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsARecord(1, strings.Repeat("a", 64), "169.45.2.20", 3600))).ToNot(Succeed())
+		})
+
+		It("fails for hosts with a trailing dot", func() {
+			Expect(validate(NewDnsARecord(1, "www.example.com.", "169.45.2.20", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsARecord(1, "www.", "169.45.2.20", 3600))).ToNot(Succeed())
+		})
+
+		It("checks the address family of address records", func() {
+			Expect(validate(NewDnsARecord(1, "www", "2607:f0d0:1002:51::4", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsARecord(1, "www", "169.45.2", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsAaaaRecord(1, "www", "169.45.2.20", 3600))).ToNot(Succeed())
+		})
+
+		It("fails for invalid targets", func() {
+			Expect(validate(NewDnsCnameRecord(1, "@", "www.example.com", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsCnameRecord(1, "ftp", "not a host", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsNsRecord(1, "dev", "", 3600))).ToNot(Succeed())
+		})
+
+		It("checks the priority of MX records", func() {
+			Expect(validate(NewDnsMxRecord(1, "@", "mail.example.com", -1, 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsMxRecord(1, "@", "mail.example.com", 65536, 3600))).ToNot(Succeed())
+		})
+
+		It("checks the data of text records", func() {
+			Expect(validate(NewDnsTxtRecord(1, "@", "", 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsSpfRecord(1, "@", "include:_spf.example.com", 3600))).ToNot(Succeed())
+		})
+
+		It("checks the service, protocol and port of SRV records", func() {
+			Expect(validate(NewDnsSrvRecord(1, "sip", "_tcp", "@", "sip.example.com", 0, 0, 5060, 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsSrvRecord(1, "_sip", "tcp", "@", "sip.example.com", 0, 0, 5060, 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsSrvRecord(1, "_sip", "_tcp", "@", "sip.example.com", 0, 0, 0, 3600))).ToNot(Succeed())
+			Expect(validate(NewDnsSrvRecord(1, "_sip", "_tcp", "@", "sip.example.com", 70000, 0, 5060, 3600))).ToNot(Succeed())
+		})
+
+		It("fails for unsupported types", func() {
+			record = datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "x86 linux", Ttl: 3600, Type: "hinfo"}
+			err := ValidateDnsResourceRecord(record)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported DNS record type 'hinfo'"))
+		})
+	})
+})
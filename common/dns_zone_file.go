@@ -0,0 +1,564 @@
+package common
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"net"
+	"strconv"
+	"strings"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+const DEFAULT_ZONE_FILE_TTL = 86400
+
+type zoneToken struct {
+	text   string
+	quoted bool
+}
+
+type zoneLine struct {
+	number    int
+	continued bool
+	tokens    []zoneToken
+}
+
+// ParseZoneFile converts an RFC 1035 zone file into a domain template. When origin is empty, the zone
+// name is taken from the first $ORIGIN directive, which must then be absolute, or from the owner of the SOA record.
+func ParseZoneFile(contents string, origin string) (datatypes.SoftLayer_Dns_Domain_Template, error) {
+	lines, err := splitZoneLines(contents)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_Template{}, err
+	}
+
+	zone := canonicalZoneName(origin)
+	if zone == "" {
+		zone = detectZoneName(lines)
+	}
+
+	if zone == "" {
+		return datatypes.SoftLayer_Dns_Domain_Template{}, errors.New("softlayer-go: could not determine the zone name, no origin given and no absolute $ORIGIN or SOA record found")
+	}
+
+	currentOrigin := zone
+	defaultTtl, lastTtl, serial := 0, 0, 0
+	owner := ""
+	records := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+
+	for _, line := range lines {
+		first := line.tokens[0].text
+
+		if !line.tokens[0].quoted && strings.HasPrefix(first, "$") {
+			if len(line.tokens) < 2 {
+				return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, fmt.Sprintf("%s requires a value", first))
+			}
+
+			switch strings.ToUpper(first) {
+			case "$ORIGIN":
+				currentOrigin = absoluteZoneName(line.tokens[1].text, currentOrigin)
+			case "$TTL":
+				defaultTtl, err = parseZoneTtl(line.tokens[1].text)
+				if err != nil {
+					return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, err.Error())
+				}
+			default:
+				return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, fmt.Sprintf("unsupported directive '%s'", first))
+			}
+
+			continue
+		}
+
+		fields := line.tokens
+		if !line.continued {
+			owner = absoluteZoneName(fields[0].text, currentOrigin)
+			fields = fields[1:]
+		}
+
+		if owner == "" {
+			return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, "record has no owner name")
+		}
+
+		ttl := 0
+		for len(fields) > 0 {
+			if strings.EqualFold(fields[0].text, "IN") {
+				fields = fields[1:]
+				continue
+			}
+
+			value, ttlErr := parseZoneTtl(fields[0].text)
+			if ttlErr != nil || ttl != 0 {
+				break
+			}
+
+			ttl = value
+			fields = fields[1:]
+		}
+
+		if len(fields) == 0 {
+			return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, "record has no type")
+		}
+
+		switch {
+		case ttl != 0:
+			lastTtl = ttl
+		case defaultTtl != 0:
+			ttl = defaultTtl
+		case lastTtl != 0:
+			ttl = lastTtl
+		default:
+			ttl = DEFAULT_ZONE_FILE_TTL
+		}
+
+		record, err := parseZoneRecord(line, strings.ToUpper(fields[0].text), fields[1:], owner, currentOrigin, zone)
+		if err != nil {
+			return datatypes.SoftLayer_Dns_Domain_Template{}, err
+		}
+
+		if record.Type == "soa" {
+			serial, err = strconv.Atoi(fields[3].text)
+			if err != nil {
+				return datatypes.SoftLayer_Dns_Domain_Template{}, zoneFileError(line, fmt.Sprintf("invalid SOA serial '%s'", fields[3].text))
+			}
+		}
+
+		record.Ttl = ttl
+		records = append(records, record)
+	}
+
+	return datatypes.SoftLayer_Dns_Domain_Template{
+		Name:            strings.TrimSuffix(zone, "."),
+		Serial:          serial,
+		ResourceRecords: records,
+	}, nil
+}
+
+// SerializeZoneFile renders the domain and its resource records as an RFC 1035 zone file. The SOA
+// record is written first, followed by the NS records and the remaining records in their given order.
+func SerializeZoneFile(domain datatypes.SoftLayer_Dns_Domain) string {
+	buffer := new(bytes.Buffer)
+	zone := canonicalZoneName(domain.Name)
+
+	fmt.Fprintf(buffer, "$ORIGIN %s\n", zone)
+
+	ordered := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	for _, recordType := range []string{"soa", "ns"} {
+		for _, record := range domain.ResourceRecords {
+			if strings.ToLower(record.Type) == recordType {
+				ordered = append(ordered, record)
+			}
+		}
+	}
+
+	for _, record := range domain.ResourceRecords {
+		recordType := strings.ToLower(record.Type)
+		if recordType != "soa" && recordType != "ns" {
+			ordered = append(ordered, record)
+		}
+	}
+
+	for _, record := range ordered {
+		fmt.Fprintf(buffer, "%s\n", formatZoneRecord(record, domain.Serial))
+	}
+
+	return buffer.String()
+}
+
+// FormatZoneRecord renders a single resource record as a zone file line, relative to its domain.
+func FormatZoneRecord(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) string {
+	return formatZoneRecord(record, 0)
+}
+
+//Private methods
+
+func formatZoneRecord(record datatypes.SoftLayer_Dns_Domain_ResourceRecord, serial int) string {
+	owner := record.Host
+	if owner == "" {
+		owner = "@"
+	}
+
+	recordType := strings.ToLower(record.Type)
+	data := record.Data
+
+	switch recordType {
+	case "soa":
+		data = fmt.Sprintf("%s %s %d %d %d %d %d", qualifyZoneName(record.Data), qualifyZoneName(record.ResponsiblePerson), serial, record.Refresh, record.Retry, record.Expire, record.Minimum)
+	case "cname", "ns", "ptr":
+		data = qualifyZoneName(record.Data)
+	case "mx":
+		data = fmt.Sprintf("%d %s", record.MxPriority, qualifyZoneName(record.Data))
+	case "txt", "spf":
+		data = quoteZoneText(record.Data)
+	case "srv":
+		data = fmt.Sprintf("%d %d %d %s", record.Priority, record.Weight, record.Port, qualifyZoneName(record.Data))
+		owner = srvOwnerName(record)
+	}
+
+	if record.Ttl > 0 {
+		return fmt.Sprintf("%s\t%d\tIN\t%s\t%s", owner, record.Ttl, strings.ToUpper(recordType), data)
+	}
+
+	return fmt.Sprintf("%s\tIN\t%s\t%s", owner, strings.ToUpper(recordType), data)
+}
+
+func parseZoneRecord(line zoneLine, recordType string, data []zoneToken, owner string, origin string, zone string) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+		Host: relativeZoneName(owner, zone),
+		Type: strings.ToLower(recordType),
+	}
+
+	expected := map[string]int{"A": 1, "AAAA": 1, "CNAME": 1, "NS": 1, "PTR": 1, "MX": 2, "SRV": 4, "SOA": 7}
+	if count, found := expected[recordType]; found && len(data) != count {
+		return record, zoneFileError(line, fmt.Sprintf("%s record expects %d values, got %d", recordType, count, len(data)))
+	}
+
+	switch recordType {
+	case "A", "AAAA":
+		ip := net.ParseIP(data[0].text)
+		if ip == nil || (recordType == "A") != (ip.To4() != nil) {
+			return record, zoneFileError(line, fmt.Sprintf("'%s' is not a valid %s record address", data[0].text, recordType))
+		}
+
+		record.Data = data[0].text
+	case "CNAME", "NS", "PTR":
+		record.Data = absoluteZoneName(data[0].text, origin)
+	case "MX":
+		priority, err := strconv.Atoi(data[0].text)
+		if err != nil {
+			return record, zoneFileError(line, fmt.Sprintf("invalid MX priority '%s'", data[0].text))
+		}
+
+		record.MxPriority = priority
+		record.Data = absoluteZoneName(data[1].text, origin)
+	case "TXT", "SPF":
+		if len(data) == 0 {
+			return record, zoneFileError(line, fmt.Sprintf("%s record has no text", recordType))
+		}
+
+		record.Data = joinZoneText(data)
+	case "SOA":
+		values := []int{}
+		for _, token := range data[2:] {
+			value, err := parseZoneTtl(token.text)
+			if err != nil {
+				return record, zoneFileError(line, fmt.Sprintf("invalid SOA value '%s'", token.text))
+			}
+			values = append(values, value)
+		}
+
+		record.Data = absoluteZoneName(data[0].text, origin)
+		record.ResponsiblePerson = absoluteZoneName(data[1].text, origin)
+		record.Refresh, record.Retry, record.Expire, record.Minimum = values[1], values[2], values[3], values[4]
+	case "SRV":
+		values := []int{}
+		for _, token := range data[:3] {
+			value, err := strconv.Atoi(token.text)
+			if err != nil {
+				return record, zoneFileError(line, fmt.Sprintf("invalid SRV value '%s'", token.text))
+			}
+			values = append(values, value)
+		}
+
+		labels := strings.SplitN(record.Host, ".", 3)
+		if len(labels) < 2 || !strings.HasPrefix(labels[0], "_") || !strings.HasPrefix(labels[1], "_") {
+			return record, zoneFileError(line, fmt.Sprintf("SRV owner '%s' must start with _service._protocol", record.Host))
+		}
+
+		record.Service, record.Protocol, record.Host = labels[0], labels[1], "@"
+		if len(labels) == 3 {
+			record.Host = labels[2]
+		}
+
+		record.Priority, record.Weight, record.Port = values[0], values[1], values[2]
+		record.Data = absoluteZoneName(data[3].text, origin)
+	default:
+		return record, zoneFileError(line, fmt.Sprintf("unsupported record type '%s'", recordType))
+	}
+
+	return record, nil
+}
+
+func splitZoneLines(contents string) ([]zoneLine, error) {
+	lines := []zoneLine{}
+	current := zoneLine{number: 1}
+	token := new(bytes.Buffer)
+	inToken, inQuote, inComment, atLineStart := false, false, false, true
+	depth, number := 0, 1
+	var err error
+
+	endToken := func(quoted bool) {
+		if inToken {
+			current.tokens = append(current.tokens, zoneToken{text: token.String(), quoted: quoted})
+			token.Reset()
+			inToken = false
+		}
+	}
+
+	endLine := func() {
+		if len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+		current = zoneLine{number: number}
+		atLineStart = true
+	}
+
+	runes := []rune(contents)
+	for i := 0; i < len(runes); i++ {
+		r := runes[i]
+
+		if r == '\n' {
+			number++
+		}
+
+		if inComment {
+			if r != '\n' {
+				continue
+			}
+			inComment = false
+		}
+
+		if inQuote {
+			switch r {
+			case '\\':
+				i, err = writeZoneEscape(token, runes, i, number)
+				if err != nil {
+					return nil, err
+				}
+			case '"':
+				inQuote = false
+				endToken(true)
+			case '\n':
+				return nil, errors.New(fmt.Sprintf("softlayer-go: zone file line %d: unterminated quoted string", number-1))
+			default:
+				token.WriteRune(r)
+			}
+			continue
+		}
+
+		if atLineStart && r != '\n' {
+			current.continued = r == ' ' || r == '\t'
+			atLineStart = false
+		}
+
+		switch r {
+		case ';':
+			endToken(false)
+			inComment = true
+		case '"':
+			endToken(false)
+			inQuote, inToken = true, true
+		case '(':
+			endToken(false)
+			depth++
+		case ')':
+			endToken(false)
+			if depth == 0 {
+				return nil, errors.New(fmt.Sprintf("softlayer-go: zone file line %d: unbalanced parenthesis", number))
+			}
+			depth--
+		case '\n':
+			endToken(false)
+			if depth == 0 {
+				endLine()
+			}
+		case ' ', '\t', '\r':
+			endToken(false)
+		case '\\':
+			i, err = writeZoneEscape(token, runes, i, number)
+			if err != nil {
+				return nil, err
+			}
+			inToken = true
+		default:
+			token.WriteRune(r)
+			inToken = true
+		}
+	}
+
+	if inQuote {
+		return nil, errors.New(fmt.Sprintf("softlayer-go: zone file line %d: unterminated quoted string", number))
+	}
+
+	if depth != 0 {
+		return nil, errors.New(fmt.Sprintf("softlayer-go: zone file line %d: unbalanced parenthesis", number))
+	}
+
+	endToken(false)
+	endLine()
+
+	return lines, nil
+}
+
+// A backslash either escapes the next character or starts a \DDD decimal byte value
+func writeZoneEscape(token *bytes.Buffer, runes []rune, i int, number int) (int, error) {
+	if i+1 >= len(runes) {
+		return i, nil
+	}
+
+	if i+3 < len(runes) && isZoneDigit(runes[i+1]) && isZoneDigit(runes[i+2]) && isZoneDigit(runes[i+3]) {
+		value, _ := strconv.Atoi(string(runes[i+1 : i+4]))
+		if value > 255 {
+			return i, errors.New(fmt.Sprintf("softlayer-go: zone file line %d: invalid escape '\\%s'", number, string(runes[i+1:i+4])))
+		}
+
+		token.WriteByte(byte(value))
+		return i + 3, nil
+	}
+
+	token.WriteRune(runes[i+1])
+	return i + 1, nil
+}
+
+func isZoneDigit(r rune) bool {
+	return r >= '0' && r <= '9'
+}
+
+func detectZoneName(lines []zoneLine) string {
+	for _, line := range lines {
+		first := line.tokens[0].text
+		if strings.EqualFold(first, "$ORIGIN") && len(line.tokens) > 1 {
+			//A relative $ORIGIN is resolved against the current origin, so it cannot name the zone
+			if !strings.HasSuffix(line.tokens[1].text, ".") {
+				return ""
+			}
+
+			return canonicalZoneName(line.tokens[1].text)
+		}
+
+		if strings.HasPrefix(first, "$") {
+			continue
+		}
+
+		for _, token := range line.tokens {
+			if strings.EqualFold(token.text, "SOA") && !line.continued && strings.HasSuffix(first, ".") {
+				return canonicalZoneName(first)
+			}
+		}
+
+		return ""
+	}
+
+	return ""
+}
+
+func parseZoneTtl(value string) (int, error) {
+	if value == "" {
+		return 0, errors.New("empty TTL")
+	}
+
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, digits := 0, ""
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		if c >= '0' && c <= '9' {
+			digits += string(c)
+			continue
+		}
+
+		multiplier, found := units[c|0x20]
+		if !found || digits == "" {
+			return 0, errors.New(fmt.Sprintf("invalid TTL '%s'", value))
+		}
+
+		number, _ := strconv.Atoi(digits)
+		total += number * multiplier
+		digits = ""
+	}
+
+	if digits != "" {
+		number, _ := strconv.Atoi(digits)
+		total += number
+	}
+
+	return total, nil
+}
+
+func canonicalZoneName(name string) string {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if name == "" {
+		return ""
+	}
+
+	return strings.TrimSuffix(name, ".") + "."
+}
+
+func absoluteZoneName(name string, origin string) string {
+	if name == "@" {
+		return origin
+	}
+
+	if strings.HasSuffix(name, ".") {
+		return name
+	}
+
+	return name + "." + origin
+}
+
+func relativeZoneName(name string, zone string) string {
+	if strings.EqualFold(name, zone) {
+		return "@"
+	}
+
+	if strings.HasSuffix(strings.ToLower(name), "."+zone) {
+		return name[:len(name)-len(zone)-1]
+	}
+
+	return name
+}
+
+func qualifyZoneName(name string) string {
+	if name == "@" || strings.HasSuffix(name, ".") || !strings.Contains(name, ".") {
+		return name
+	}
+
+	return name + "."
+}
+
+func srvOwnerName(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) string {
+	owner := fmt.Sprintf("%s.%s", record.Service, record.Protocol)
+	if record.Host != "" && record.Host != "@" {
+		owner += "." + record.Host
+	}
+
+	return owner
+}
+
+func joinZoneText(tokens []zoneToken) string {
+	parts := []string{}
+	allQuoted := true
+	for _, token := range tokens {
+		parts = append(parts, token.text)
+		allQuoted = allQuoted && token.quoted
+	}
+
+	if allQuoted {
+		return strings.Join(parts, "")
+	}
+
+	return strings.Join(parts, " ")
+}
+
+func quoteZoneText(text string) string {
+	escaped := strings.Replace(strings.Replace(text, `\`, `\\`, -1), `"`, `\"`, -1)
+
+	//Character strings are limited to 255 bytes, longer texts are split into several strings
+	parts := []string{}
+	for len(escaped) > 255 {
+		cut := 255
+		for cut > 0 && escaped[cut-1] == '\\' {
+			cut--
+		}
+		if cut == 0 {
+			cut = 254
+		}
+		parts = append(parts, `"`+escaped[:cut]+`"`)
+		escaped = escaped[cut:]
+	}
+	parts = append(parts, `"`+escaped+`"`)
+
+	return strings.Join(parts, " ")
+}
+
+func zoneFileError(line zoneLine, message string) error {
+	return errors.New(fmt.Sprintf("softlayer-go: zone file line %d: %s", line.number, message))
+}
@@ -0,0 +1,248 @@
+package common_test
+
+import (
+	"strings"
+
+	. "github.com/maximilien/softlayer-go/common"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+var _ = Describe("DNS zone files", func() {
+	var (
+		zoneFile string
+		template datatypes.SoftLayer_Dns_Domain_Template
+		err      error
+	)
+
+	Context("#ParseZoneFile", func() {
+		BeforeEach(func() {
+			zoneFile = strings.Join([]string{
+				"$ORIGIN example.com.",
+				"$TTL 1h",
+				"@   IN  SOA ns1.softlayer.com. root.example.com. (",
+				"        2016031001 ; serial",
+				"        3600       ; refresh",
+				"        5m         ; retry",
+				"        1w         ; expire",
+				"        3600 )     ; minimum",
+				"    IN  NS  ns1.softlayer.com.",
+				"    IN  NS  ns2.softlayer.com.",
+				"www 300 IN A    169.45.2.20",
+				"        IN AAAA 2607:f0d0:1002:51::4",
+				"ftp IN  CNAME www",
+				"@   IN  MX  10 mail",
+				"@   IN  TXT \"v=spf1 include:_spf.example.com \" \"~all\"",
+				"@   IN  SPF \"v=spf1 -all\"",
+				"_sip._tcp.voip 7200 IN SRV 10 60 5060 sip.example.com.",
+				"$ORIGIN 2.45.169.in-addr.arpa.",
+				"20  IN  PTR www.example.com.",
+			}, "\n")
+		})
+
+		It("converts every supported record type", func() {
+			template, err = ParseZoneFile(zoneFile, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.com"))
+
+			records := template.ResourceRecords
+			Expect(records).To(HaveLen(11))
+
+			Expect(records[0].Type).To(Equal("soa"))
+			Expect(records[0].Host).To(Equal("@"))
+			Expect(records[0].Data).To(Equal("ns1.softlayer.com."))
+			Expect(records[0].ResponsiblePerson).To(Equal("root.example.com."))
+			Expect(records[0].Refresh).To(Equal(3600))
+			Expect(records[0].Retry).To(Equal(300))
+			Expect(records[0].Expire).To(Equal(604800))
+			Expect(records[0].Minimum).To(Equal(3600))
+
+			Expect(records[1].Type).To(Equal("ns"))
+			Expect(records[1].Host).To(Equal("@"))
+			Expect(records[2].Data).To(Equal("ns2.softlayer.com."))
+
+			Expect(records[3]).To(Equal(datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "www", Data: "169.45.2.20", Ttl: 300, Type: "a"}))
+			Expect(records[4].Host).To(Equal("www"))
+			Expect(records[4].Type).To(Equal("aaaa"))
+			Expect(records[4].Ttl).To(Equal(3600))
+
+			Expect(records[5].Type).To(Equal("cname"))
+			Expect(records[5].Data).To(Equal("www.example.com."))
+
+			Expect(records[6].Type).To(Equal("mx"))
+			Expect(records[6].MxPriority).To(Equal(10))
+			Expect(records[6].Data).To(Equal("mail.example.com."))
+
+			Expect(records[7].Type).To(Equal("txt"))
+			Expect(records[7].Data).To(Equal("v=spf1 include:_spf.example.com ~all"))
+			Expect(records[8].Type).To(Equal("spf"))
+			Expect(records[8].Data).To(Equal("v=spf1 -all"))
+
+			Expect(records[9].Type).To(Equal("srv"))
+			Expect(records[9].Host).To(Equal("voip"))
+			Expect(records[9].Service).To(Equal("_sip"))
+			Expect(records[9].Protocol).To(Equal("_tcp"))
+			Expect(records[9].Priority).To(Equal(10))
+			Expect(records[9].Weight).To(Equal(60))
+			Expect(records[9].Port).To(Equal(5060))
+			Expect(records[9].Ttl).To(Equal(7200))
+
+			Expect(records[10].Type).To(Equal("ptr"))
+			Expect(records[10].Host).To(Equal("20.2.45.169.in-addr.arpa."))
+			Expect(records[10].Data).To(Equal("www.example.com."))
+		})
+
+		It("keeps the serial of the SOA record", func() {
+			template, err = ParseZoneFile(zoneFile, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Serial).To(Equal(2016031001))
+		})
+
+		It("decodes \\DDD escapes in TXT data", func() {
+			template, err = ParseZoneFile("$ORIGIN example.com.\n@ IN TXT \"a\\059b\\\"c\" d\\032e", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.ResourceRecords[0].Data).To(Equal("a;b\"c d e"))
+		})
+
+		It("fails for \\DDD escapes above 255", func() {
+			_, err = ParseZoneFile("$ORIGIN example.com.\n@ IN TXT \"a\\256\"", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("uses the given origin over the one found in the file", func() {
+			template, err = ParseZoneFile("www IN A 169.45.2.20", "Example.org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.org"))
+			Expect(template.ResourceRecords[0].Host).To(Equal("www"))
+		})
+
+		It("takes the zone name from the SOA owner when there is no $ORIGIN", func() {
+			template, err = ParseZoneFile("example.net. IN SOA ns1.softlayer.com. root.example.net. 1 2 3 4 5\nwww.example.net. IN A 169.45.2.21", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.net"))
+			Expect(template.ResourceRecords[1].Host).To(Equal("www"))
+		})
+
+		It("relativizes names of a nested $ORIGIN against the zone", func() {
+			template, err = ParseZoneFile("$ORIGIN example.com.\n$ORIGIN dev\napi IN A 10.0.0.1", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.ResourceRecords[0].Host).To(Equal("api.dev"))
+		})
+
+		It("fails when the zone name would come from a relative $ORIGIN", func() {
+			_, err = ParseZoneFile("$ORIGIN example.com\nwww IN A 10.0.0.1", "")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("resolves a relative $ORIGIN against the given origin", func() {
+			template, err = ParseZoneFile("$ORIGIN dev\napi IN A 10.0.0.1", "example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.com"))
+			Expect(template.ResourceRecords[0].Host).To(Equal("api.dev"))
+		})
+
+		It("reuses the last explicit TTL when there is no $TTL", func() {
+			template, err = ParseZoneFile("$ORIGIN example.com.\nwww 600 IN A 10.0.0.1\napi IN A 10.0.0.2", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.ResourceRecords[1].Ttl).To(Equal(600))
+		})
+
+		It("keeps escaped quotes and semicolons inside text records", func() {
+			template, err = ParseZoneFile(`@ IN TXT "say \"hi\"; bye"`, "example.com")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.ResourceRecords[0].Data).To(Equal(`say "hi"; bye`))
+		})
+
+		It("fails for SOA records with missing values", func() {
+			zoneFile = "$ORIGIN example.com.\n$TTL 86400\n@\t86400\tIN\tSOA\tns1.softlayer.com. root.example.com. (\n\t\t\t\t2016031001 ; serial\n\t\t\t\t3600 )     ; minimum\n"
+			_, err = ParseZoneFile(zoneFile, "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("SOA record expects 7 values, got 4"))
+		})
+
+		It("reports the line of invalid records", func() {
+			_, err = ParseZoneFile("$ORIGIN example.com.\n\nwww IN A 2607:f0d0:1002:51::4", "")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("line 3"))
+		})
+
+		It("fails for unsupported record types and directives", func() {
+			_, err = ParseZoneFile("@ IN HINFO x86 linux", "example.com")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseZoneFile("$INCLUDE other.zone", "example.com")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails for malformed files", func() {
+			_, err = ParseZoneFile("@ IN SOA ns1. root. ( 1 2 3 4 5", "example.com")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseZoneFile("@ IN TXT \"unterminated\n", "example.com")
+			Expect(err).To(HaveOccurred())
+
+			_, err = ParseZoneFile("_sip IN SRV 1 2 3 sip.example.com.", "example.com")
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the zone name cannot be determined", func() {
+			_, err = ParseZoneFile("www IN A 10.0.0.1", "")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#SerializeZoneFile", func() {
+		var domain datatypes.SoftLayer_Dns_Domain
+
+		BeforeEach(func() {
+			domain = datatypes.SoftLayer_Dns_Domain{
+				Name:   "example.com",
+				Serial: 2016031001,
+				ResourceRecords: []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "www", Data: "169.45.2.20", Ttl: 3600, Type: "a"},
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "ns1.softlayer.com.", Ttl: 86400, Type: "ns"},
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "ns1.softlayer.com.", ResponsiblePerson: "root.example.com.", Refresh: 3600, Retry: 300, Expire: 604800, Minimum: 3600, Ttl: 86400, Type: "soa"},
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "mail.example.com", MxPriority: 10, Ttl: 3600, Type: "mx"},
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: `say "hi"`, Type: "txt"},
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "sip.example.com", Service: "_sip", Protocol: "_tcp", Priority: 10, Weight: 60, Port: 5060, Ttl: 7200, Type: "srv"},
+				},
+			}
+		})
+
+		It("writes the SOA and NS records first", func() {
+			Expect(SerializeZoneFile(domain)).To(Equal(strings.Join([]string{
+				"$ORIGIN example.com.",
+				"@\t86400\tIN\tSOA\tns1.softlayer.com. root.example.com. 2016031001 3600 300 604800 3600",
+				"@\t86400\tIN\tNS\tns1.softlayer.com.",
+				"www\t3600\tIN\tA\t169.45.2.20",
+				"@\t3600\tIN\tMX\t10 mail.example.com.",
+				"@\tIN\tTXT\t\"say \\\"hi\\\"\"",
+				"_sip._tcp\t7200\tIN\tSRV\t10 60 5060 sip.example.com.",
+				"",
+			}, "\n")))
+		})
+
+		It("splits long texts into several character strings", func() {
+			domain.ResourceRecords = []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: strings.Repeat("a", 300), Type: "txt"},
+			}
+
+			Expect(SerializeZoneFile(domain)).To(ContainSubstring(`"` + strings.Repeat("a", 255) + `" "` + strings.Repeat("a", 45) + `"`))
+		})
+
+		It("produces a zone file that parses back into the same records", func() {
+			template, err = ParseZoneFile(SerializeZoneFile(domain), "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.com"))
+			Expect(template.ResourceRecords).To(HaveLen(6))
+			Expect(template.ResourceRecords[0].Type).To(Equal("soa"))
+			Expect(template.ResourceRecords[3].Data).To(Equal("mail.example.com."))
+			Expect(template.ResourceRecords[4].Data).To(Equal(`say "hi"`))
+			Expect(template.ResourceRecords[5].Service).To(Equal("_sip"))
+			Expect(template.ResourceRecords[5].Host).To(Equal("@"))
+		})
+	})
+})
@@ -1,18 +1,38 @@
 package data_types
 
+import (
+	"time"
+)
+
 type SoftLayer_Billing_Item_Cancellation_Request_Parameters struct {
 	Parameters []SoftLayer_Billing_Item_Cancellation_Request `json:"parameters"`
 }
 
+type SoftLayer_Billing_Item_Cancellation_Request_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
 type SoftLayer_Billing_Item_Cancellation_Request struct {
-	ComplexType string                                             `json:"complexType"`
-	AccountId   int                                                `json:"accountId"`
-	Id          int                                                `json:"id"`
-	TicketId    int                                                `json:"ticketId"`
-	Items       []SoftLayer_Billing_Item_Cancellation_Request_Item `json:"items"`
+	ComplexType           string                                              `json:"complexType"`
+	AccountId             int                                                 `json:"accountId"`
+	Id                    int                                                 `json:"id"`
+	TicketId              int                                                 `json:"ticketId"`
+	BillingCancelReasonId int                                                 `json:"billingCancelReasonId,omitempty"`
+	Notes                 string                                              `json:"notes,omitempty"`
+	CreateDate            *time.Time                                          `json:"createDate,omitempty"`
+	StatusId              int                                                 `json:"statusId,omitempty"`
+	Status                *SoftLayer_Billing_Item_Cancellation_Request_Status `json:"status,omitempty"`
+	Items                 []SoftLayer_Billing_Item_Cancellation_Request_Item  `json:"items"`
 }
 
 type SoftLayer_Billing_Item_Cancellation_Request_Item struct {
-	BillingItemId             int  `json:"billingItemId"`
-	ImmediateCancellationFlag bool `json:"immediateCancellationFlag"`
+	BillingItemId             int        `json:"billingItemId"`
+	ImmediateCancellationFlag bool       `json:"immediateCancellationFlag"`
+	ScheduledCancellationDate *time.Time `json:"scheduledCancellationDate,omitempty"`
+}
+
+type SoftLayer_Billing_Item_Cancellation_Request_Status struct {
+	Id      int    `json:"id"`
+	KeyName string `json:"keyName"`
+	Name    string `json:"name"`
 }
@@ -12,6 +12,34 @@ type SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi_Paramete
 	Parameters []SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi `json:"parameters"`
 }
 
+type SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Network_Storage_AsAService_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_Storage_AsAService `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Hardware_Server_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Hardware_Server `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Network_Vlan_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_Vlan `json:"parameters"`
+}
+
+type SoftLayer_Container_Product_Order_Network_Subnet_Parameters struct {
+	Parameters []SoftLayer_Container_Product_Order_Network_Subnet `json:"parameters"`
+}
+
 type SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade_Parameters struct {
 	Parameters []SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade `json:"parameters"`
 }
@@ -29,15 +57,62 @@ type SoftLayer_Container_Product_Order struct {
 
 //http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi
 type SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi struct {
-	ComplexType      string                                  `json:"complexType"`
-	Location         string                                  `json:"location,omitempty"`
-	PackageId        int                                     `json:"packageId"`
-	Prices           []SoftLayer_Product_Item_Price          `json:"prices,omitempty"`
-	VirtualGuests    []VirtualGuest                          `json:"virtualGuests,omitempty"`
-	Properties       []Property                              `json:"properties,omitempty"`
-	Quantity         int                                     `json:"quantity,omitempty"`
-	OsFormatType     SoftLayer_Network_Storage_Iscsi_OS_Type `json:"osFormatType,omitempty"`
-	UseHourlyPricing bool                                    `json:"useHourlyPricing,omitempty"`
+	ComplexType      string                                            `json:"complexType"`
+	Location         string                                            `json:"location,omitempty"`
+	PackageId        int                                               `json:"packageId"`
+	Prices           []SoftLayer_Product_Item_Price                    `json:"prices,omitempty"`
+	VirtualGuests    []VirtualGuest                                    `json:"virtualGuests,omitempty"`
+	Properties       []Property                                        `json:"properties,omitempty"`
+	Quantity         int                                               `json:"quantity,omitempty"`
+	OsFormatType     *SoftLayer_Network_Storage_Iscsi_OS_Type_Template `json:"osFormatType,omitempty"`
+	UseHourlyPricing bool                                              `json:"useHourlyPricing,omitempty"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs
+type SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs struct {
+	ComplexType      string                         `json:"complexType"`
+	Location         string                         `json:"location,omitempty"`
+	PackageId        int                            `json:"packageId"`
+	Prices           []SoftLayer_Product_Item_Price `json:"prices,omitempty"`
+	Quantity         int                            `json:"quantity,omitempty"`
+	UseHourlyPricing bool                           `json:"useHourlyPricing,omitempty"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_Storage_AsAService
+type SoftLayer_Container_Product_Order_Network_Storage_AsAService struct {
+	ComplexType               string                                            `json:"complexType"`
+	Location                  string                                            `json:"location,omitempty"`
+	PackageId                 int                                               `json:"packageId"`
+	Prices                    []SoftLayer_Product_Item_Price                    `json:"prices,omitempty"`
+	Quantity                  int                                               `json:"quantity,omitempty"`
+	VolumeSize                int                                               `json:"volumeSize,omitempty"`
+	Iops                      int                                               `json:"iops,omitempty"`
+	OsFormatType              *SoftLayer_Network_Storage_Iscsi_OS_Type_Template `json:"osFormatType,omitempty"`
+	OriginVolumeId            int                                               `json:"originVolumeId,omitempty"`
+	OriginVolumeScheduleId    int                                               `json:"originVolumeScheduleId,omitempty"`
+	DuplicateOriginVolumeId   int                                               `json:"duplicateOriginVolumeId,omitempty"`
+	DuplicateOriginSnapshotId int                                               `json:"duplicateOriginSnapshotId,omitempty"`
+	UseHourlyPricing          bool                                              `json:"useHourlyPricing,omitempty"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade
+type SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade struct {
+	ComplexType string                         `json:"complexType"`
+	PackageId   int                            `json:"packageId"`
+	Prices      []SoftLayer_Product_Item_Price `json:"prices,omitempty"`
+	Quantity    int                            `json:"quantity,omitempty"`
+	VolumeSize  int                            `json:"volumeSize,omitempty"`
+	Iops        int                            `json:"iops,omitempty"`
+	Volume      *Volume                        `json:"volume"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace
+type SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace struct {
+	ComplexType string                         `json:"complexType"`
+	PackageId   int                            `json:"packageId"`
+	Prices      []SoftLayer_Product_Item_Price `json:"prices,omitempty"`
+	Quantity    int                            `json:"quantity,omitempty"`
+	VolumeId    int                            `json:"volumeId"`
 }
 
 //http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade
@@ -51,6 +126,50 @@ type SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade struct {
 	Quantity      int                            `json:"quantity,omitempty"`
 }
 
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Hardware_Server
+type SoftLayer_Container_Product_Order_Hardware_Server struct {
+	ComplexType      string                                      `json:"complexType"`
+	Location         string                                      `json:"location,omitempty"`
+	PackageId        int                                         `json:"packageId"`
+	PresetId         int                                         `json:"presetId,omitempty"`
+	Prices           []SoftLayer_Product_Item_Price              `json:"prices,omitempty"`
+	Hardware         []Hardware                                  `json:"hardware,omitempty"`
+	Quantity         int                                         `json:"quantity,omitempty"`
+	UseHourlyPricing bool                                        `json:"useHourlyPricing,omitempty"`
+	SshKeys          []SoftLayer_Container_Product_Order_SshKeys `json:"sshKeys,omitempty"`
+	ProvisionScripts []string                                    `json:"provisionScripts,omitempty"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_Vlan
+type SoftLayer_Container_Product_Order_Network_Vlan struct {
+	ComplexType string                         `json:"complexType"`
+	Location    string                         `json:"location,omitempty"`
+	PackageId   int                            `json:"packageId"`
+	Prices      []SoftLayer_Product_Item_Price `json:"prices,omitempty"`
+	Quantity    int                            `json:"quantity,omitempty"`
+	Name        string                         `json:"name,omitempty"`
+	RouterId    int                            `json:"routerId,omitempty"`
+}
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Container_Product_Order_Network_Subnet
+type SoftLayer_Container_Product_Order_Network_Subnet struct {
+	ComplexType         string                         `json:"complexType"`
+	PackageId           int                            `json:"packageId"`
+	Prices              []SoftLayer_Product_Item_Price `json:"prices,omitempty"`
+	Quantity            int                            `json:"quantity,omitempty"`
+	EndPointVlanId      int                            `json:"endPointVlanId,omitempty"`
+	EndPointIpAddressId int                            `json:"endPointIpAddressId,omitempty"`
+}
+
+type SoftLayer_Container_Product_Order_SshKeys struct {
+	SshKeyIds []int `json:"sshKeyIds"`
+}
+
+type Hardware struct {
+	Hostname string `json:"hostname"`
+	Domain   string `json:"domain"`
+}
+
 type Property struct {
 	Name  string `json:"name"`
 	Value string `json:"value"`
@@ -59,3 +178,7 @@ type Property struct {
 type VirtualGuest struct {
 	Id int `json:"id"`
 }
+
+type Volume struct {
+	Id int `json:"id"`
+}
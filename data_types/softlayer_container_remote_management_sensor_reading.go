@@ -0,0 +1,14 @@
+package data_types
+
+type SoftLayer_Container_RemoteManagement_SensorReading struct {
+	SensorId            string `json:"sensorId"`
+	SensorReading       string `json:"sensorReading"`
+	SensorUnits         string `json:"sensorUnits"`
+	Status              string `json:"status"`
+	LowerCritical       string `json:"lowerCritical,omitempty"`
+	LowerNonCritical    string `json:"lowerNonCritical,omitempty"`
+	LowerNonRecoverable string `json:"lowerNonRecoverable,omitempty"`
+	UpperCritical       string `json:"upperCritical,omitempty"`
+	UpperNonCritical    string `json:"upperNonCritical,omitempty"`
+	UpperNonRecoverable string `json:"upperNonRecoverable,omitempty"`
+}
@@ -0,0 +1,7 @@
+package data_types
+
+type SoftLayer_Container_Virtual_ConsoleData struct {
+	Password string `json:"password"`
+	Port     int    `json:"port"`
+	Uri      string `json:"uri"`
+}
@@ -2,6 +2,7 @@ package data_types
 
 type SoftLayer_Dns_Domain_Template struct {
 	Name            string                                `json:"name"`
+	Serial          int                                   `json:"serial,omitempty"`
 	ResourceRecords []SoftLayer_Dns_Domain_ResourceRecord `json:"resourceRecords"`
 }
 
@@ -9,6 +10,10 @@ type SoftLayer_Dns_Domain_Template_Parameters struct {
 	Parameters []SoftLayer_Dns_Domain_Template `json:"parameters"`
 }
 
+type SoftLayer_Dns_Domain_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
 type SoftLayer_Dns_Domain struct {
 	Id                  int                                   `json:"id"`
 	Name                string                                `json:"name"`
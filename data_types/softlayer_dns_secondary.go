@@ -0,0 +1,34 @@
+package data_types
+
+type SoftLayer_Dns_Secondary_Template struct {
+	ZoneName          string `json:"zoneName,omitempty"`
+	MasterIpAddress   string `json:"masterIpAddress,omitempty"`
+	TransferFrequency int    `json:"transferFrequency,omitempty"`
+}
+
+type SoftLayer_Dns_Secondary_Template_Parameters struct {
+	Parameters []SoftLayer_Dns_Secondary_Template `json:"parameters"`
+}
+
+type SoftLayer_Dns_Secondary struct {
+	Id                int                     `json:"id"`
+	ZoneName          string                  `json:"zoneName"`
+	MasterIpAddress   string                  `json:"masterIpAddress"`
+	TransferFrequency int                     `json:"transferFrequency"`
+	StatusId          int                     `json:"statusId"`
+	StatusText        string                  `json:"statusText"`
+	CreateDate        string                  `json:"createDate"`
+	LastUpdate        string                  `json:"lastUpdate"`
+	ErrorMessages     []SoftLayer_Dns_Message `json:"errorMessages,omitempty"`
+}
+
+type SoftLayer_Dns_Message struct {
+	Id               int    `json:"id"`
+	CreateDate       string `json:"createDate"`
+	DomainId         int    `json:"domainId"`
+	ResourceRecordId int    `json:"resourceRecordId"`
+	SecondaryId      int    `json:"secondaryId"`
+	Message          string `json:"message"`
+	Priority         string `json:"priority"`
+	Type             string `json:"type"`
+}
@@ -41,6 +41,10 @@ type SoftLayer_Hardware struct {
 	Datacenter *SoftLayer_Location `json:"datacenter"`
 }
 
+type SoftLayer_Hardware_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
 type SoftLayer_Hardware_String_Parameters struct {
 	Parameters []string `json:"parameters"`
 }
@@ -0,0 +1,33 @@
+package data_types
+
+type SoftLayer_Hardware_Component struct {
+	Id                     int                                 `json:"id"`
+	HardwareId             int                                 `json:"hardwareId"`
+	SerialNumber           string                              `json:"serialNumber,omitempty"`
+	HardwareComponentModel *SoftLayer_Hardware_Component_Model `json:"hardwareComponentModel,omitempty"`
+}
+
+type SoftLayer_Hardware_Component_Model struct {
+	Id           int    `json:"id"`
+	Name         string `json:"name"`
+	Description  string `json:"description"`
+	Manufacturer string `json:"manufacturer"`
+	Version      string `json:"version"`
+
+	HardwareGenericComponentModel *SoftLayer_Hardware_Component_Model_Generic `json:"hardwareGenericComponentModel,omitempty"`
+}
+
+type SoftLayer_Hardware_Component_Model_Generic struct {
+	Id          int    `json:"id"`
+	Capacity    string `json:"capacity"`
+	Units       string `json:"units"`
+	Description string `json:"description"`
+
+	HardwareComponentType *SoftLayer_Hardware_Component_Type `json:"hardwareComponentType,omitempty"`
+}
+
+type SoftLayer_Hardware_Component_Type struct {
+	Id      int    `json:"id"`
+	KeyName string `json:"keyName"`
+	Type    string `json:"type"`
+}
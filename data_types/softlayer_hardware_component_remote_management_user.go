@@ -0,0 +1,10 @@
+package data_types
+
+type SoftLayer_Hardware_Component_RemoteManagement_User struct {
+	Id                   int    `json:"id"`
+	HardwareId           int    `json:"hardwareId"`
+	Username             string `json:"username"`
+	Password             string `json:"password"`
+	IpmiPrivilegeLevel   string `json:"ipmiPrivilegeLevel,omitempty"`
+	RemoteManagementType string `json:"remoteManagementType,omitempty"`
+}
@@ -1,7 +1,25 @@
 package data_types
 
 type SoftLayer_Location struct {
-	Id       int    `json:"id"`
-	LongName string `json:"longName"`
-	Name     string `json:"name"`
+	Id          int                        `json:"id"`
+	LongName    string                     `json:"longName"`
+	Name        string                     `json:"name"`
+	PriceGroups []SoftLayer_Location_Group `json:"priceGroups,omitempty"`
+}
+
+type SoftLayer_Location_Group struct {
+	Id   int    `json:"id"`
+	Name string `json:"name"`
+}
+
+type SoftLayer_Location_Region struct {
+	Description string                       `json:"description"`
+	Keyname     string                       `json:"keyname"`
+	SortOrder   int                          `json:"sortOrder"`
+	Location    *SoftLayer_Location_Location `json:"location,omitempty"`
+}
+
+type SoftLayer_Location_Location struct {
+	LocationId int                 `json:"locationId"`
+	Location   *SoftLayer_Location `json:"location,omitempty"`
 }
@@ -0,0 +1,11 @@
+package data_types
+
+import (
+	"time"
+)
+
+type SoftLayer_Metric_Tracking_Object_Data struct {
+	Counter  float64    `json:"counter"`
+	DateTime *time.Time `json:"dateTime"`
+	Type     string     `json:"type"`
+}
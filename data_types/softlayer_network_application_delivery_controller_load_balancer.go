@@ -0,0 +1,87 @@
+package data_types
+
+import (
+	"time"
+)
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Parameters struct {
+	Parameters []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress `json:"parameters"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress struct {
+	Id                          int        `json:"id,omitempty"`
+	Name                        string     `json:"name,omitempty"`
+	ConnectionLimit             int        `json:"connectionLimit,omitempty"`
+	LoadBalancingMethod         string     `json:"loadBalancingMethod,omitempty"`
+	LoadBalancingMethodFullName string     `json:"loadBalancingMethodFullName,omitempty"`
+	ModifyDate                  *time.Time `json:"modifyDate,omitempty"`
+	Notes                       string     `json:"notes,omitempty"`
+	SecurityCertificateId       int        `json:"securityCertificateId,omitempty"`
+	SslActiveFlag               bool       `json:"sslActiveFlag,omitempty"`
+	SslEnabledFlag              bool       `json:"sslEnabledFlag,omitempty"`
+	VirtualIpAddress            string     `json:"virtualIpAddress,omitempty"`
+
+	VirtualServers []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer `json:"virtualServers,omitempty"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer struct {
+	Id                 int `json:"id,omitempty"`
+	Allocation         int `json:"allocation,omitempty"`
+	Port               int `json:"port,omitempty"`
+	RoutingMethodId    int `json:"routingMethodId,omitempty"`
+	VirtualIpAddressId int `json:"virtualIpAddressId,omitempty"`
+
+	ServiceGroups []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group `json:"serviceGroups,omitempty"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group struct {
+	Id              int `json:"id,omitempty"`
+	RoutingMethodId int `json:"routingMethodId,omitempty"`
+	RoutingTypeId   int `json:"routingTypeId,omitempty"`
+	Timeout         int `json:"timeout,omitempty"`
+
+	Services []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service `json:"services,omitempty"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service struct {
+	Id             int    `json:"id,omitempty"`
+	Enabled        *int   `json:"enabled,omitempty"`
+	IpAddressId    int    `json:"ipAddressId,omitempty"`
+	Notes          string `json:"notes,omitempty"`
+	Port           int    `json:"port,omitempty"`
+	ServiceGroupId int    `json:"serviceGroupId,omitempty"`
+	Status         string `json:"status,omitempty"`
+
+	IpAddress       *SoftLayer_Network_Subnet_IpAddress                                                      `json:"ipAddress,omitempty"`
+	HealthChecks    []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check            `json:"healthChecks,omitempty"`
+	GroupReferences []SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Reference `json:"groupReferences,omitempty"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check struct {
+	Id                int `json:"id,omitempty"`
+	HealthCheckTypeId int `json:"healthCheckTypeId,omitempty"`
+	ServiceId         int `json:"serviceId,omitempty"`
+
+	Type *SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type `json:"type,omitempty"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check_Type struct {
+	Id      int    `json:"id"`
+	KeyName string `json:"keyName"`
+	Name    string `json:"name"`
+}
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Reference struct {
+	ServiceGroupId int `json:"serviceGroupId,omitempty"`
+	ServiceId      int `json:"serviceId,omitempty"`
+	Weight         int `json:"weight,omitempty"`
+}
+
+type SoftLayer_Container_Network_LoadBalancer_StatusEntry struct {
+	Label string `json:"label"`
+	Value string `json:"value"`
+}
@@ -0,0 +1,7 @@
+package data_types
+
+type SoftLayer_Network_Bandwidth_Usage struct {
+	AmountIn  string `json:"amountIn"`
+	AmountOut string `json:"amountOut"`
+	Type      string `json:"type"`
+}
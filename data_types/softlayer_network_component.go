@@ -0,0 +1,28 @@
+package data_types
+
+import "time"
+
+type SoftLayer_Network_Component_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+type SoftLayer_Network_Component struct {
+	CreateDate       *time.Time `json:"createDate,omitempty"`
+	HardwareId       int        `json:"hardwareId,omitempty"`
+	Id               int        `json:"id,omitempty"`
+	MacAddress       string     `json:"macAddress,omitempty"`
+	MaxSpeed         int        `json:"maxSpeed,omitempty"`
+	ModifyDate       *time.Time `json:"modifyDate,omitempty"`
+	Name             string     `json:"name,omitempty"`
+	Port             int        `json:"port,omitempty"`
+	PrimaryIpAddress string     `json:"primaryIpAddress,omitempty"`
+	Speed            int        `json:"speed,omitempty"`
+	Status           string     `json:"status,omitempty"`
+}
+
+type SoftLayer_Network_Component_Network_Vlan_Trunk struct {
+	Id                 int                     `json:"id,omitempty"`
+	NetworkComponentId int                     `json:"networkComponentId,omitempty"`
+	NetworkVlanId      int                     `json:"networkVlanId,omitempty"`
+	NetworkVlan        *SoftLayer_Network_Vlan `json:"networkVlan,omitempty"`
+}
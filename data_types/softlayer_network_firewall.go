@@ -0,0 +1,55 @@
+package data_types
+
+import (
+	"time"
+)
+
+type SoftLayer_Network_Component_Firewall struct {
+	Id                      int    `json:"id"`
+	Status                  string `json:"status,omitempty"`
+	NetworkComponentId      int    `json:"networkComponentId,omitempty"`
+	GuestNetworkComponentId int    `json:"guestNetworkComponentId,omitempty"`
+
+	Rules []SoftLayer_Network_Firewall_Update_Request_Rule `json:"rules,omitempty"`
+}
+
+type SoftLayer_Network_Vlan_Firewall struct {
+	Id                       int    `json:"id"`
+	FirewallType             string `json:"firewallType,omitempty"`
+	FullyQualifiedDomainName string `json:"fullyQualifiedDomainName,omitempty"`
+	PrimaryIpAddress         string `json:"primaryIpAddress,omitempty"`
+
+	NetworkVlan *SoftLayer_Network_Vlan                          `json:"networkVlan,omitempty"`
+	Rules       []SoftLayer_Network_Firewall_Update_Request_Rule `json:"rules,omitempty"`
+}
+
+type SoftLayer_Network_Firewall_Update_Request_Parameters struct {
+	Parameters []SoftLayer_Network_Firewall_Update_Request `json:"parameters"`
+}
+
+type SoftLayer_Network_Firewall_Update_Request struct {
+	Id                                 int        `json:"id,omitempty"`
+	CreateDate                         *time.Time `json:"createDate,omitempty"`
+	BypassFlag                         bool       `json:"bypassFlag"`
+	NetworkComponentFirewallId         int        `json:"networkComponentFirewallId,omitempty"`
+	FirewallContextAccessControlListId int        `json:"firewallContextAccessControlListId,omitempty"`
+	Status                             string     `json:"status,omitempty"`
+
+	Rules []SoftLayer_Network_Firewall_Update_Request_Rule `json:"rules"`
+}
+
+//Rules read from component and VLAN firewalls share this layout, so they can be edited and resubmitted as is
+type SoftLayer_Network_Firewall_Update_Request_Rule struct {
+	Id                        int    `json:"id,omitempty"`
+	OrderValue                int    `json:"orderValue"`
+	Action                    string `json:"action"`
+	Protocol                  string `json:"protocol"`
+	SourceIpAddress           string `json:"sourceIpAddress"`
+	SourceIpCidr              int    `json:"sourceIpCidr"`
+	DestinationIpAddress      string `json:"destinationIpAddress"`
+	DestinationIpCidr         int    `json:"destinationIpCidr"`
+	DestinationPortRangeStart int    `json:"destinationPortRangeStart,omitempty"`
+	DestinationPortRangeEnd   int    `json:"destinationPortRangeEnd,omitempty"`
+	Version                   int    `json:"version,omitempty"`
+	Notes                     string `json:"notes,omitempty"`
+}
@@ -0,0 +1,30 @@
+package data_types
+
+type SoftLayer_Network_LoadBalancer_Global_Account_Parameters struct {
+	Parameters []SoftLayer_Network_LoadBalancer_Global_Account `json:"parameters"`
+}
+
+type SoftLayer_Network_LoadBalancer_Global_Account struct {
+	Id                          int     `json:"id,omitempty"`
+	Hostname                    string  `json:"hostname,omitempty"`
+	AverageConnectionsPerSecond float64 `json:"averageConnectionsPerSecond,omitempty"`
+	ConnectionsPerSecond        int     `json:"connectionsPerSecond,omitempty"`
+	FallbackIp                  string  `json:"fallbackIp,omitempty"`
+	LoadBalanceTypeId           int     `json:"loadBalanceTypeId,omitempty"`
+	Notes                       string  `json:"notes,omitempty"`
+
+	Hosts []SoftLayer_Network_LoadBalancer_Global_Host `json:"hosts,omitempty"`
+}
+
+type SoftLayer_Network_LoadBalancer_Global_Host struct {
+	Id                    int    `json:"id,omitempty"`
+	DestinationIp         string `json:"destinationIp,omitempty"`
+	DestinationPort       int    `json:"destinationPort,omitempty"`
+	Enabled               int    `json:"enabled"`
+	HealthCheck           string `json:"healthCheck,omitempty"`
+	LoadBalancerAccountId int    `json:"loadBalancerAccountId,omitempty"`
+	Location              string `json:"location,omitempty"`
+	Order                 int    `json:"order,omitempty"`
+	Status                string `json:"status,omitempty"`
+	Weight                int    `json:"weight,omitempty"`
+}
@@ -0,0 +1,53 @@
+package data_types
+
+import (
+	"time"
+)
+
+type SoftLayer_Network_SecurityGroup_Parameters struct {
+	Parameters []SoftLayer_Network_SecurityGroup `json:"parameters"`
+}
+
+type SoftLayer_Network_SecurityGroup_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+type SoftLayer_Network_SecurityGroup struct {
+	Id          int        `json:"id,omitempty"`
+	Name        string     `json:"name,omitempty"`
+	Description string     `json:"description,omitempty"`
+	CreateDate  *time.Time `json:"createDate,omitempty"`
+	ModifyDate  *time.Time `json:"modifyDate,omitempty"`
+
+	Rules                    []SoftLayer_Network_SecurityGroup_Rule                            `json:"rules,omitempty"`
+	NetworkComponentBindings []SoftLayer_Virtual_Network_SecurityGroup_NetworkComponentBinding `json:"networkComponentBindings,omitempty"`
+}
+
+type SoftLayer_Network_SecurityGroup_Rule struct {
+	Id              int    `json:"id,omitempty"`
+	SecurityGroupId int    `json:"securityGroupId,omitempty"`
+	Direction       string `json:"direction,omitempty"`
+	Ethertype       string `json:"ethertype,omitempty"`
+	PortRangeMin    *int   `json:"portRangeMin,omitempty"`
+	PortRangeMax    *int   `json:"portRangeMax,omitempty"`
+	Protocol        string `json:"protocol,omitempty"`
+	RemoteGroupId   int    `json:"remoteGroupId,omitempty"`
+	RemoteIp        string `json:"remoteIp,omitempty"`
+}
+
+type SoftLayer_Network_SecurityGroup_Request struct {
+	RequestId int `json:"requestId"`
+}
+
+type SoftLayer_Network_SecurityGroup_RequestRules struct {
+	RequestId int                                    `json:"requestId"`
+	Rules     []SoftLayer_Network_SecurityGroup_Rule `json:"rules"`
+}
+
+type SoftLayer_Virtual_Network_SecurityGroup_NetworkComponentBinding struct {
+	Id                 int `json:"id,omitempty"`
+	NetworkComponentId int `json:"networkComponentId,omitempty"`
+	SecurityGroupId    int `json:"securityGroupId,omitempty"`
+
+	NetworkComponent *SoftLayer_Virtual_Guest_Network_Component `json:"networkComponent,omitempty"`
+}
@@ -21,11 +21,40 @@ type SoftLayer_Network_Storage struct {
 	BillingItem                     *Billing_Item `json:"billingItem,omitempty"`
 	LunId                           string        `json:"lunId,omitempty"`
 	ServiceResourceBackendIpAddress string        `json:"serviceResourceBackendIpAddress,omitempty"`
+	FileNetworkMountAddress         string        `json:"fileNetworkMountAddress,omitempty"`
+	StorageTierLevel                string        `json:"storageTierLevel,omitempty"`
+	SnapshotCapacityGb              string        `json:"snapshotCapacityGb,omitempty"`
+	SnapshotCreationTimestamp       string        `json:"snapshotCreationTimestamp,omitempty"`
+	SnapshotSizeBytes               string        `json:"snapshotSizeBytes,omitempty"`
+	ReplicationStatus               string        `json:"replicationStatus,omitempty"`
+	ProvisionedIops                 string        `json:"provisionedIops,omitempty"`
+
+	StorageType     *SoftLayer_Network_Storage_Type          `json:"storageType,omitempty"`
+	OsType          *SoftLayer_Network_Storage_Iscsi_OS_Type `json:"osType,omitempty"`
+	Schedules       []SoftLayer_Network_Storage_Schedule     `json:"schedules,omitempty"`
+	ServiceResource *SoftLayer_Network_Service_Resource      `json:"serviceResource,omitempty"`
+}
+
+type SoftLayer_Network_Storage_Type struct {
+	Id          int    `json:"id"`
+	KeyName     string `json:"keyName"`
+	Description string `json:"description,omitempty"`
+}
+
+type SoftLayer_Network_Service_Resource struct {
+	Id         int                 `json:"id"`
+	Name       string              `json:"name,omitempty"`
+	Datacenter *SoftLayer_Location `json:"datacenter,omitempty"`
+}
+
+type SoftLayer_Network_Storage_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
 }
 
 type Billing_Item struct {
-	Id        int         `json:"id,omitempty"`
-	OrderItem *Order_Item `json:"orderItem,omitempty"`
+	Id           int         `json:"id,omitempty"`
+	CategoryCode string      `json:"categoryCode,omitempty"`
+	OrderItem    *Order_Item `json:"orderItem,omitempty"`
 }
 
 type Order_Item struct {
@@ -10,3 +10,8 @@ type SoftLayer_Network_Storage_Iscsi_OS_Type struct {
 	Name       string    `json:"name"`
 	KeyName    string    `json:"keyName"`
 }
+
+type SoftLayer_Network_Storage_Iscsi_OS_Type_Template struct {
+	Id      int    `json:"id,omitempty"`
+	KeyName string `json:"keyName,omitempty"`
+}
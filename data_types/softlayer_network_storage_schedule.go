@@ -0,0 +1,18 @@
+package data_types
+
+type SoftLayer_Network_Storage_Schedule struct {
+	Id             int                                      `json:"id"`
+	Name           string                                   `json:"name,omitempty"`
+	Active         int                                      `json:"active"`
+	RetentionCount string                                   `json:"retentionCount,omitempty"`
+	MinuteOfHour   string                                   `json:"minuteOfHour,omitempty"`
+	HourOfDay      string                                   `json:"hourOfDay,omitempty"`
+	DayOfWeek      string                                   `json:"dayOfWeek,omitempty"`
+	Type           *SoftLayer_Network_Storage_Schedule_Type `json:"type,omitempty"`
+}
+
+type SoftLayer_Network_Storage_Schedule_Type struct {
+	Id      int    `json:"id"`
+	Keyname string `json:"keyname"`
+	Name    string `json:"name,omitempty"`
+}
@@ -0,0 +1,28 @@
+package data_types
+
+type SoftLayer_Network_Subnet_Parameters struct {
+	Parameters []SoftLayer_Network_Subnet `json:"parameters"`
+}
+
+type SoftLayer_Network_Subnet_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+type SoftLayer_Network_Subnet struct {
+	Id                   int    `json:"id"`
+	NetworkIdentifier    string `json:"networkIdentifier"`
+	Cidr                 int    `json:"cidr"`
+	Netmask              string `json:"netmask,omitempty"`
+	Gateway              string `json:"gateway,omitempty"`
+	BroadcastAddress     string `json:"broadcastAddress,omitempty"`
+	SubnetType           string `json:"subnetType,omitempty"`
+	NetworkVlanId        int    `json:"networkVlanId,omitempty"`
+	TotalIpAddresses     string `json:"totalIpAddresses,omitempty"`
+	UsableIpAddressCount string `json:"usableIpAddressCount,omitempty"`
+	Version              int    `json:"version,omitempty"`
+	Note                 string `json:"note,omitempty"`
+
+	Datacenter *SoftLayer_Location `json:"datacenter,omitempty"`
+
+	IpAddresses []SoftLayer_Network_Subnet_IpAddress `json:"ipAddresses,omitempty"`
+}
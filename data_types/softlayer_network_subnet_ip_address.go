@@ -0,0 +1,22 @@
+package data_types
+
+type SoftLayer_Network_Subnet_IpAddress_Parameters struct {
+	Parameters []SoftLayer_Network_Subnet_IpAddress `json:"parameters"`
+}
+
+type SoftLayer_Network_Subnet_IpAddress struct {
+	Id          int    `json:"id,omitempty"`
+	IpAddress   string `json:"ipAddress,omitempty"`
+	SubnetId    int    `json:"subnetId,omitempty"`
+	IsBroadcast bool   `json:"isBroadcast,omitempty"`
+	IsGateway   bool   `json:"isGateway,omitempty"`
+	IsNetwork   bool   `json:"isNetwork,omitempty"`
+	IsReserved  bool   `json:"isReserved,omitempty"`
+
+	//Always sent on edit so that notes can be cleared
+	Note string `json:"note"`
+
+	Subnet       *SoftLayer_Network_Subnet `json:"subnet,omitempty"`
+	VirtualGuest *SoftLayer_Virtual_Guest  `json:"virtualGuest,omitempty"`
+	Hardware     *SoftLayer_Hardware       `json:"hardware,omitempty"`
+}
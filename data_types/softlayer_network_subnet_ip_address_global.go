@@ -0,0 +1,15 @@
+package data_types
+
+type SoftLayer_Network_Subnet_IpAddress_Global_InitParameters struct {
+	Parameters []interface{} `json:"parameters"`
+}
+
+type SoftLayer_Network_Subnet_IpAddress_Global struct {
+	Id                     int    `json:"id"`
+	IpAddressId            int    `json:"ipAddressId"`
+	DestinationIpAddressId int    `json:"destinationIpAddressId,omitempty"`
+	Description            string `json:"description,omitempty"`
+
+	IpAddress            *SoftLayer_Network_Subnet_IpAddress `json:"ipAddress,omitempty"`
+	DestinationIpAddress *SoftLayer_Network_Subnet_IpAddress `json:"destinationIpAddress,omitempty"`
+}
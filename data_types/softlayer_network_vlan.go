@@ -4,13 +4,50 @@ import (
 	"time"
 )
 
+type SoftLayer_Network_Vlan_Template struct {
+	Name *string `json:"name,omitempty"`
+	Note *string `json:"note,omitempty"`
+}
+
+type SoftLayer_Network_Vlan_Template_Parameters struct {
+	Parameters []SoftLayer_Network_Vlan_Template `json:"parameters"`
+}
+
 type SoftLayer_Network_Vlan struct {
-	AccountId       int        `json:"accountId"`
-	Id              int        `json:"Id"`
+	AccountId       int        `json:"accountId,omitempty"`
+	Id              int        `json:"id,omitempty"`
 	ModifyDate      *time.Time `json:"modifyDate,omitempty"`
-	Name            string     `json:"name"`
-	NetworkVrfId    int        `json:"networkVrfId"`
-	Note            string     `json:"note"`
-	PrimarySubnetId int        `json:"primarySubnetId"`
-	VlanNumber      int        `json:"vlanNumber"`
+	Name            string     `json:"name,omitempty"`
+	NetworkSpace    string     `json:"networkSpace,omitempty"`
+	NetworkVrfId    int        `json:"networkVrfId,omitempty"`
+	Note            string     `json:"note,omitempty"`
+	PrimarySubnetId int        `json:"primarySubnetId,omitempty"`
+	VlanNumber      int        `json:"vlanNumber,omitempty"`
+
+	PrimaryRouter *SoftLayer_Hardware_Router `json:"primaryRouter,omitempty"`
+
+	Subnets            []SoftLayer_Network_Subnet             `json:"subnets,omitempty"`
+	VirtualGuests      []SoftLayer_Virtual_Guest              `json:"virtualGuests,omitempty"`
+	Hardware           []SoftLayer_Hardware                   `json:"hardware,omitempty"`
+	FirewallInterfaces []SoftLayer_Network_Firewall_Interface `json:"firewallInterfaces,omitempty"`
+}
+
+type SoftLayer_Hardware_Router struct {
+	Id       int    `json:"id"`
+	Hostname string `json:"hostname"`
+
+	Datacenter *SoftLayer_Location `json:"datacenter,omitempty"`
+}
+
+type SoftLayer_Network_Firewall_Interface struct {
+	Id            int    `json:"id"`
+	Name          string `json:"name"`
+	NetworkVlanId int    `json:"networkVlanId"`
+
+	FirewallContextAccessControlLists []SoftLayer_Network_Firewall_AccessControlList `json:"firewallContextAccessControlLists,omitempty"`
+}
+
+type SoftLayer_Network_Firewall_AccessControlList struct {
+	Id        int    `json:"id"`
+	Direction string `json:"direction"`
 }
@@ -3,17 +3,24 @@ package data_types
 import "strconv"
 
 type SoftLayer_Product_Item_Price struct {
-	Id              int         `json:"id"`
-	LocationGroupId int         `json:"locationGroupId"`
-	Categories      []Category  `json:"categories,omitempty"`
-	Item            *Item       `json:"item,omitempty"`
-	Attributes      *Attributes `json:"attributes,omitempty"`
+	Id                         int         `json:"id"`
+	LocationGroupId            int         `json:"locationGroupId"`
+	Categories                 []Category  `json:"categories,omitempty"`
+	Item                       *Item       `json:"item,omitempty"`
+	Attributes                 *Attributes `json:"attributes,omitempty"`
+	CapacityRestrictionType    string      `json:"capacityRestrictionType,omitempty"`
+	CapacityRestrictionMinimum string      `json:"capacityRestrictionMinimum,omitempty"`
+	CapacityRestrictionMaximum string      `json:"capacityRestrictionMaximum,omitempty"`
 }
 
 type Item struct {
-	Id          int    `json:"id"`
-	Description string `json:"description"`
-	Capacity    string `json:"capacity"`
+	Id              int    `json:"id"`
+	KeyName         string `json:"keyName,omitempty"`
+	Description     string `json:"description"`
+	Capacity        string `json:"capacity"`
+	CapacityMinimum string `json:"capacityMinimum,omitempty"`
+	CapacityMaximum string `json:"capacityMaximum,omitempty"`
+	Units           string `json:"units,omitempty"`
 }
 
 type Category struct {
@@ -0,0 +1,10 @@
+package data_types
+
+//http://sldn.softlayer.com/reference/datatypes/SoftLayer_Product_Package_Preset
+type SoftLayer_Product_Package_Preset struct {
+	Id          int    `json:"id"`
+	KeyName     string `json:"keyName"`
+	Name        string `json:"name"`
+	Description string `json:"description"`
+	PackageId   int    `json:"packageId"`
+}
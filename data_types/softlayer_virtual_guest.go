@@ -103,6 +103,16 @@ type BlockDeviceTemplateGroup struct {
 type NetworkComponents struct {
 	//Required, defaults to 10
 	MaxSpeed int `json:"maxSpeed,omitempty"`
+
+	SecurityGroupBindings []SecurityGroupBinding `json:"securityGroupBindings,omitempty"`
+}
+
+type SecurityGroupBinding struct {
+	SecurityGroup SecurityGroup `json:"securityGroup"`
+}
+
+type SecurityGroup struct {
+	Id int `json:"id"`
 }
 
 type NetworkVlan struct {
@@ -113,11 +123,15 @@ type NetworkVlan struct {
 type PrimaryNetworkComponent struct {
 	//Required
 	NetworkVlan NetworkVlan `json:"networkVlan,omitempty"`
+
+	SecurityGroupBindings []SecurityGroupBinding `json:"securityGroupBindings,omitempty"`
 }
 
 type PrimaryBackendNetworkComponent struct {
 	//Required
 	NetworkVlan NetworkVlan `json:"networkVlan,omitempty"`
+
+	SecurityGroupBindings []SecurityGroupBinding `json:"securityGroupBindings,omitempty"`
 }
 
 type DiskImage struct {
@@ -146,5 +160,10 @@ type SoftLayer_Virtual_Guest_SetTags_Parameters struct {
 }
 
 type Image_Template_Config struct {
-	ImageTemplateId string `json:"imageTemplateId"`
+	ImageTemplateId              string `json:"imageTemplateId,omitempty"`
+	OperatingSystemReferenceCode string `json:"operatingSystemReferenceCode,omitempty"`
+	SshKeyIds                    []int  `json:"sshKeyIds,omitempty"`
+	CustomProvisionScriptUri     string `json:"customProvisionScriptUri,omitempty"`
+	KeepDataDisks                bool   `json:"keepDataDisks,omitempty"`
+	LockboxFlag                  bool   `json:"lockboxFlag,omitempty"`
 }
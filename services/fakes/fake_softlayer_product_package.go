@@ -23,6 +23,14 @@ func (fps *FakeProductPackageService) GetItemsByType(packageType string) ([]data
 	return productItems, nil
 }
 
+func (fps *FakeProductPackageService) GetActivePresets(packageId int) ([]datatypes.SoftLayer_Product_Package_Preset, error) {
+	return []datatypes.SoftLayer_Product_Package_Preset{}, errors.New("Not supported")
+}
+
+func (fps *FakeProductPackageService) GetRegions(packageId int) ([]datatypes.SoftLayer_Location_Region, error) {
+	return []datatypes.SoftLayer_Location_Region{}, errors.New("Not supported")
+}
+
 func (fps *FakeProductPackageService) GetItemPrices(packageId int, filters string) ([]datatypes.SoftLayer_Product_Item_Price, error) {
 	return []datatypes.SoftLayer_Product_Item_Price{}, errors.New("Not supported")
 }
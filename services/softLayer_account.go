@@ -397,6 +397,42 @@ func (slas *softLayer_Account_Service) GetHardware() ([]datatypes.SoftLayer_Hard
 	return hardwares, nil
 }
 
+func (slas *softLayer_Account_Service) GetHardwareWithFilter(filter string) ([]datatypes.SoftLayer_Hardware, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getHardware.json")
+
+	objectMasks := []string{
+		"id",
+		"hostname",
+		"domain",
+		"hardwareStatusId",
+		"provisionDate",
+		"globalIdentifier",
+		"primaryIpAddress",
+		"primaryBackendIpAddress",
+	}
+
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, objectMasks, filter, "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getHardware, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getHardware, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Hardware{}, errors.New(errorMessage)
+	}
+
+	hardwares := []datatypes.SoftLayer_Hardware{}
+	err = json.Unmarshal(responseBytes, &hardwares)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Hardware{}, err
+	}
+
+	return hardwares, nil
+}
+
 func (slas *softLayer_Account_Service) GetDomains() ([]datatypes.SoftLayer_Dns_Domain, error) {
 	path := fmt.Sprintf("%s/%s", slas.GetName(), "getDomains.json")
 	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
@@ -420,3 +456,222 @@ func (slas *softLayer_Account_Service) GetDomains() ([]datatypes.SoftLayer_Dns_D
 
 	return domains, nil
 }
+
+func (slas *softLayer_Account_Service) GetSecondaryDomains() ([]datatypes.SoftLayer_Dns_Secondary, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSecondaryDomains.json")
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequest(path, "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSecondaryDomains, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Dns_Secondary{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSecondaryDomains, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Dns_Secondary{}, errors.New(errorMessage)
+	}
+
+	secondaryDomains := []datatypes.SoftLayer_Dns_Secondary{}
+	err = json.Unmarshal(responseBytes, &secondaryDomains)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	return secondaryDomains, nil
+}
+
+func (slas *softLayer_Account_Service) GetNetworkVlans() ([]datatypes.SoftLayer_Network_Vlan, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getNetworkVlans.json")
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectMask(path, slas.networkVlanObjectMask(), "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkVlans, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkVlans, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	networkVlans := []datatypes.SoftLayer_Network_Vlan{}
+	err = json.Unmarshal(responseBytes, &networkVlans)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	return networkVlans, nil
+}
+
+func (slas *softLayer_Account_Service) GetNetworkVlansWithFilter(filter string) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getNetworkVlans.json")
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, slas.networkVlanObjectMask(), filter, "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkVlans, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getNetworkVlans, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	networkVlans := []datatypes.SoftLayer_Network_Vlan{}
+	err = json.Unmarshal(responseBytes, &networkVlans)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	return networkVlans, nil
+}
+
+func (slas *softLayer_Account_Service) GetNetworkVlansByDatacenterAndRouter(datacenter string, routerHostname string) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	if datacenter == "" && routerHostname == "" {
+		return slas.GetNetworkVlans()
+	}
+
+	primaryRouter := map[string]interface{}{}
+	if datacenter != "" {
+		primaryRouter["datacenter"] = map[string]interface{}{
+			"name": map[string]string{"operation": datacenter},
+		}
+	}
+
+	if routerHostname != "" {
+		primaryRouter["hostname"] = map[string]string{"operation": routerHostname}
+	}
+
+	filter, err := json.Marshal(map[string]interface{}{
+		"networkVlans": map[string]interface{}{
+			"primaryRouter": primaryRouter,
+		},
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	return slas.GetNetworkVlansWithFilter(string(filter))
+}
+
+func (slas *softLayer_Account_Service) GetSubnets() ([]datatypes.SoftLayer_Network_Subnet, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSubnets.json")
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectMask(path, slas.subnetObjectMask(), "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSubnets, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Network_Subnet{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSubnets, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Subnet{}, errors.New(errorMessage)
+	}
+
+	subnets := []datatypes.SoftLayer_Network_Subnet{}
+	err = json.Unmarshal(responseBytes, &subnets)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Subnet{}, err
+	}
+
+	return subnets, nil
+}
+
+func (slas *softLayer_Account_Service) GetSubnetsWithFilter(filter string) ([]datatypes.SoftLayer_Network_Subnet, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getSubnets.json")
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, slas.subnetObjectMask(), filter, "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSubnets, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Network_Subnet{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getSubnets, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Subnet{}, errors.New(errorMessage)
+	}
+
+	subnets := []datatypes.SoftLayer_Network_Subnet{}
+	err = json.Unmarshal(responseBytes, &subnets)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Subnet{}, err
+	}
+
+	return subnets, nil
+}
+
+func (slas *softLayer_Account_Service) GetGlobalIpRecords() ([]datatypes.SoftLayer_Network_Subnet_IpAddress_Global, error) {
+	path := fmt.Sprintf("%s/%s", slas.GetName(), "getGlobalIpRecords.json")
+
+	objectMasks := []string{
+		"id",
+		"ipAddressId",
+		"destinationIpAddressId",
+		"description",
+		"ipAddress.id",
+		"ipAddress.ipAddress",
+		"destinationIpAddress.id",
+		"destinationIpAddress.ipAddress",
+	}
+
+	responseBytes, errorCode, err := slas.client.GetHttpClient().DoRawHttpRequestWithObjectMask(path, objectMasks, "GET", &bytes.Buffer{})
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getGlobalIpRecords, error message '%s'", err.Error())
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, errors.New(errorMessage)
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Account#getGlobalIpRecords, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, errors.New(errorMessage)
+	}
+
+	globalIps := []datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}
+	err = json.Unmarshal(responseBytes, &globalIps)
+	if err != nil {
+		errorMessage := fmt.Sprintf("softlayer-go: failed to decode JSON response, err message '%s'", err.Error())
+		err := errors.New(errorMessage)
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, err
+	}
+
+	return globalIps, nil
+}
+
+//Private methods
+
+func (slas *softLayer_Account_Service) networkVlanObjectMask() []string {
+	return []string{
+		"id",
+		"accountId",
+		"name",
+		"note",
+		"networkSpace",
+		"primarySubnetId",
+		"vlanNumber",
+		"primaryRouter.id",
+		"primaryRouter.hostname",
+		"primaryRouter.datacenter",
+	}
+}
+
+func (slas *softLayer_Account_Service) subnetObjectMask() []string {
+	return []string{
+		"id",
+		"networkIdentifier",
+		"cidr",
+		"netmask",
+		"gateway",
+		"broadcastAddress",
+		"subnetType",
+		"networkVlanId",
+		"totalIpAddresses",
+		"usableIpAddressCount",
+		"version",
+		"note",
+		"datacenter",
+	}
+}
@@ -512,6 +512,45 @@ var _ = Describe("SoftLayer_Account_Service", func() {
 		})
 	})
 
+	Context("#GetHardwareWithFilter", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getHardware_provisioned.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Hardware matching the filter", func() {
+			hardwares, err := accountService.GetHardwareWithFilter(`{"hardware":{"hostname":{"operation":"bm-test"}}}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(hardwares)).To(Equal(1))
+			Expect(hardwares[0].Id).To(Equal(1122334))
+			Expect(hardwares[0].ProvisionDate).ToNot(BeNil())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"hardware":{"hostname":{"operation":"bm-test"}}}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetHardwareWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetHardwareWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#GetDomains", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getDomains.json")
@@ -547,4 +586,243 @@ var _ = Describe("SoftLayer_Account_Service", func() {
 			})
 		})
 	})
+
+	Context("#GetSecondaryDomains", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getSecondaryDomains.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Dns_Secondary", func() {
+			secondaryDomains, err := accountService.GetSecondaryDomains()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondaryDomains).To(HaveLen(2))
+			Expect(secondaryDomains[0].ZoneName).To(Equal("example.org"))
+			Expect(secondaryDomains[1].StatusText).To(Equal("Transfer Error"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Account/getSecondaryDomains.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSecondaryDomains()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSecondaryDomains()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetNetworkVlans", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getNetworkVlans.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Network_Vlan", func() {
+			networkVlans, err := accountService.GetNetworkVlans()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(networkVlans)).To(Equal(2))
+			Expect(networkVlans[0].NetworkSpace).To(Equal("PUBLIC"))
+			Expect(networkVlans[1].PrimaryRouter.Hostname).To(Equal("bcr01a.dal10"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Account/getNetworkVlans.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetNetworkVlans()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetNetworkVlans()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetNetworkVlansByDatacenterAndRouter", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getNetworkVlans.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("filters the network vlans by datacenter and router", func() {
+			_, err := accountService.GetNetworkVlansByDatacenterAndRouter("dal10", "bcr01a.dal10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"networkVlans":{"primaryRouter":{"datacenter":{"name":{"operation":"dal10"}},"hostname":{"operation":"bcr01a.dal10"}}}}`))
+		})
+
+		It("filters the network vlans by datacenter only", func() {
+			_, err := accountService.GetNetworkVlansByDatacenterAndRouter("dal10", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"networkVlans":{"primaryRouter":{"datacenter":{"name":{"operation":"dal10"}}}}}`))
+		})
+
+		It("does not filter when neither datacenter nor router is given", func() {
+			networkVlans, err := accountService.GetNetworkVlansByDatacenterAndRouter("", "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(networkVlans)).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(""))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Account/getNetworkVlans.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetNetworkVlansByDatacenterAndRouter("dal10", "")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetNetworkVlansByDatacenterAndRouter("dal10", "")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetSubnets", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getSubnets.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Network_Subnet", func() {
+			subnets, err := accountService.GetSubnets()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(subnets)).To(Equal(2))
+			Expect(subnets[0].SubnetType).To(Equal("SECONDARY_ON_VLAN"))
+			Expect(subnets[0].Note).To(Equal("cluster-a"))
+			Expect(subnets[1].TotalIpAddresses).To(Equal("64"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Account/getSubnets.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSubnets()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSubnets()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetSubnetsWithFilter", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getSubnets.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Network_Subnet matching the filter", func() {
+			subnets, err := accountService.GetSubnetsWithFilter(`{"subnets":{"subnetType":{"operation":"SECONDARY_ON_VLAN"}}}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(subnets)).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"subnets":{"subnetType":{"operation":"SECONDARY_ON_VLAN"}}}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSubnetsWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetSubnetsWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetGlobalIpRecords", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Account_Service_getGlobalIpRecords.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an array of datatypes.SoftLayer_Network_Subnet_IpAddress_Global", func() {
+			globalIps, err := accountService.GetGlobalIpRecords()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(globalIps)).To(Equal(2))
+			Expect(globalIps[0].IpAddress.IpAddress).To(Equal("169.55.1.20"))
+			Expect(globalIps[0].DestinationIpAddress.IpAddress).To(Equal("169.45.12.34"))
+			Expect(globalIps[1].DestinationIpAddress).To(BeNil())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Account/getGlobalIpRecords.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetGlobalIpRecords()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := accountService.GetGlobalIpRecords()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
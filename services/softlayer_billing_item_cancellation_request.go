@@ -11,6 +11,12 @@ import (
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
+const (
+	BILLING_ITEM_CANCELLATION_REQUEST_COMPLEX_TYPE = "SoftLayer_Billing_Item_Cancellation_Request"
+
+	CANCELLATION_REQUEST_STATUS_PENDING = "PENDING"
+)
+
 type softLayer_Billing_Item_Cancellation_Request_Service struct {
 	client softlayer.Client
 }
@@ -55,3 +61,117 @@ func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CreateObject(
 
 	return result, nil
 }
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) CancelBillingItem(billingItemId int, options *softlayer.CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	if options == nil {
+		options = &softlayer.CancellationOptions{}
+	}
+
+	request := datatypes.SoftLayer_Billing_Item_Cancellation_Request{
+		ComplexType:           BILLING_ITEM_CANCELLATION_REQUEST_COMPLEX_TYPE,
+		BillingCancelReasonId: options.ReasonId,
+		Notes:                 options.Notes,
+		Items: []datatypes.SoftLayer_Billing_Item_Cancellation_Request_Item{
+			datatypes.SoftLayer_Billing_Item_Cancellation_Request_Item{
+				BillingItemId:             billingItemId,
+				ImmediateCancellationFlag: options.Immediate,
+			},
+		},
+	}
+
+	return slbicr.CreateObject(request)
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) GetObject(id int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	objectMask := []string{
+		"items",
+		"status",
+	}
+
+	response, errorCode, err := slbicr.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slbicr.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, errors.New(errorMessage)
+	}
+
+	result := datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
+	err = json.Unmarshal(response, &result)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return result, nil
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) GetAllCancellationRequestsWithFilter(filter string) ([]datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	objectMask := []string{
+		"items",
+		"status",
+	}
+
+	response, errorCode, err := slbicr.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/getAllCancellationRequests.json", slbicr.GetName()), objectMask, filter, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#getAllCancellationRequests, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, errors.New(errorMessage)
+	}
+
+	requests := []datatypes.SoftLayer_Billing_Item_Cancellation_Request{}
+	err = json.Unmarshal(response, &requests)
+	if err != nil {
+		return []datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return requests, nil
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) GetPendingCancellationRequest(billingItemId int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	filter := fmt.Sprintf(`{"items":{"billingItemId":{"operation":%d}}}`, billingItemId)
+
+	requests, err := slbicr.GetAllCancellationRequestsWithFilter(filter)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	for _, request := range requests {
+		if request.Status != nil && request.Status.KeyName == CANCELLATION_REQUEST_STATUS_PENDING {
+			return request, nil
+		}
+	}
+
+	return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, nil
+}
+
+func (slbicr *softLayer_Billing_Item_Cancellation_Request_Service) Void(id int, closeRelatedTicket bool) (bool, error) {
+	parameters := datatypes.SoftLayer_Billing_Item_Cancellation_Request_InitParameters{
+		Parameters: []interface{}{closeRelatedTicket},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slbicr.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/void.json", slbicr.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Billing_Item_Cancellation_Request#void, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to void cancellation request with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
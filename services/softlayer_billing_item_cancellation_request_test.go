@@ -84,4 +84,178 @@ var _ = Describe("SoftLayer_Billing_Item_Cancellation_Request", func() {
 			})
 		})
 	})
+
+	Context("#CancelBillingItem", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Billing_Item_Cancellation_Request_Service_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a cancellation request on the anniversary date with reason and notes", func() {
+			result, err := billingItemCancellationRequestService.CancelBillingItem(1234, &softlayer.CancellationOptions{
+				ReasonId: 8,
+				Notes:    "No longer needed",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result.Id).To(Equal(123))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/createObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Billing_Item_Cancellation_Request","accountId":0,"id":0,"ticketId":0,"billingCancelReasonId":8,"notes":"No longer needed","items":[{"billingItemId":1234,"immediateCancellationFlag":false}]}]}`))
+		})
+
+		It("creates an immediate cancellation request", func() {
+			_, err := billingItemCancellationRequestService.CancelBillingItem(1234, &softlayer.CancellationOptions{Immediate: true})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"items":[{"billingItemId":1234,"immediateCancellationFlag":true}]`))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Billing_Item_Cancellation_Request_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the cancellation request with its items and status", func() {
+			result, err := billingItemCancellationRequestService.GetObject(123)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/123/getObject.json"))
+			Expect(result.Id).To(Equal(123))
+			Expect(result.BillingCancelReasonId).To(Equal(8))
+			Expect(result.Notes).To(Equal("No longer needed"))
+			Expect(result.Status.KeyName).To(Equal("APPROVED"))
+			Expect(len(result.Items)).To(Equal(1))
+			Expect(result.Items[0].BillingItemId).To(Equal(1234))
+			Expect(result.Items[0].ScheduledCancellationDate).ToNot(BeNil())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := billingItemCancellationRequestService.GetObject(123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := billingItemCancellationRequestService.GetObject(123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetAllCancellationRequestsWithFilter", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the cancellation requests matching the filter", func() {
+			requests, err := billingItemCancellationRequestService.GetAllCancellationRequestsWithFilter(`{"ticketId":{"operation":789}}`)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(requests)).To(Equal(2))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/getAllCancellationRequests.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"ticketId":{"operation":789}}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := billingItemCancellationRequestService.GetAllCancellationRequestsWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := billingItemCancellationRequestService.GetAllCancellationRequestsWithFilter("")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetPendingCancellationRequest", func() {
+		It("returns the pending cancellation request of the billing item", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			request, err := billingItemCancellationRequestService.GetPendingCancellationRequest(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"items":{"billingItemId":{"operation":1234}}}`))
+		})
+
+		It("returns an empty cancellation request when the requests are approved or void", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests_none_pending.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			request, err := billingItemCancellationRequestService.GetPendingCancellationRequest(1234)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(0))
+		})
+	})
+
+	Context("#Void", func() {
+		It("voids the cancellation request", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			voided, err := billingItemCancellationRequestService.Void(123, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(voided).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/123/void.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[true]}`))
+		})
+
+		It("fails to void the cancellation request", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			voided, err := billingItemCancellationRequestService.Void(123, true)
+			Expect(err).To(HaveOccurred())
+			Expect(voided).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := billingItemCancellationRequestService.Void(123, true)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := billingItemCancellationRequestService.Void(123, true)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
@@ -5,12 +5,18 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"net/url"
+	"strings"
 
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 )
 
+const (
+	DNS_RESOURCE_RECORDS_PAGE_SIZE = 500
+)
+
 type softLayer_Dns_Domain_Service struct {
 	client softlayer.Client
 }
@@ -135,3 +141,410 @@ func (sldds *softLayer_Dns_Domain_Service) GetByDomainName(name string) ([]datat
 
 	return domains, nil
 }
+
+func (sldds *softLayer_Dns_Domain_Service) CreatePtrRecord(ipAddress string, ptrRecord string, ttl int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Dns_Domain_InitParameters{Parameters: []interface{}{ipAddress, ptrRecord, ttl}})
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createPtrRecord.json", sldds.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#createPtrRecord, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+	}
+
+	err = sldds.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	err = json.Unmarshal(response, &record)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	return record, nil
+}
+
+func (sldds *softLayer_Dns_Domain_Service) GetZoneFileContents(dnsId int) (string, error) {
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getZoneFileContents.json", sldds.GetName(), dnsId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#getZoneFileContents, HTTP error code: '%d'", errorCode)
+		return "", errors.New(errorMessage)
+	}
+
+	zoneFile := ""
+	err = json.Unmarshal(response, &zoneFile)
+	if err != nil {
+		return "", err
+	}
+
+	return zoneFile, nil
+}
+
+func (sldds *softLayer_Dns_Domain_Service) ExportZone(dnsId int) (string, error) {
+	return sldds.GetZoneFileContents(dnsId)
+}
+
+func (sldds *softLayer_Dns_Domain_Service) ImportZone(zoneFile string) (datatypes.SoftLayer_Dns_Domain, error) {
+	template, err := common.ParseZoneFile(zoneFile, "")
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain{}, err
+	}
+
+	//SoftLayer generates the SOA and apex NS records of new domains itself
+	records := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	for _, record := range template.ResourceRecords {
+		if record.Type == "soa" || (record.Type == "ns" && record.Host == "@") {
+			continue
+		}
+
+		records = append(records, record)
+	}
+	template.ResourceRecords = records
+
+	return sldds.CreateObject(template)
+}
+
+func (sldds *softLayer_Dns_Domain_Service) GetResourceRecords(dnsId int, filter softlayer.DnsResourceRecordFilter, offset int, limit int) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	if offset < 0 || limit < 1 {
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(fmt.Sprintf("softlayer-go: invalid result limit '%d,%d' for SoftLayer_Dns_Domain#getResourceRecords", offset, limit))
+	}
+
+	objectFilter, err := resourceRecordsObjectFilter(filter)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	objectMask := []string{
+		"data",
+		"domainId",
+		"host",
+		"id",
+		"mxPriority",
+		"ttl",
+		"type",
+		"service",
+		"priority",
+		"protocol",
+		"port",
+		"weight",
+	}
+
+	path := fmt.Sprintf("%s/%d/getResourceRecords.json?resultLimit=%d,%d", sldds.GetName(), dnsId, offset, limit)
+	response, errorCode, err := sldds.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(path, objectMask, objectFilter, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Domain#getResourceRecords, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+	}
+
+	records := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	err = json.Unmarshal(response, &records)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	return records, nil
+}
+
+func (sldds *softLayer_Dns_Domain_Service) GetAllResourceRecords(dnsId int, filter softlayer.DnsResourceRecordFilter) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	records := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+
+	for offset := 0; ; offset += DNS_RESOURCE_RECORDS_PAGE_SIZE {
+		page, err := sldds.GetResourceRecords(dnsId, filter, offset, DNS_RESOURCE_RECORDS_PAGE_SIZE)
+		if err != nil {
+			return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+		}
+
+		records = append(records, page...)
+		if len(page) < DNS_RESOURCE_RECORDS_PAGE_SIZE {
+			return records, nil
+		}
+	}
+}
+
+func (sldds *softLayer_Dns_Domain_Service) FindRecords(domainName string, host string, recordType string) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	domains, err := sldds.GetByDomainName(domainName)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	//getByDomainName also returns domains whose names only contain the given name
+	for _, domain := range domains {
+		if strings.EqualFold(domain.Name, strings.TrimSuffix(domainName, ".")) {
+			return sldds.GetAllResourceRecords(domain.Id, softlayer.DnsResourceRecordFilter{Host: host, Type: recordType})
+		}
+	}
+
+	return []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(fmt.Sprintf("softlayer-go: could not find DNS domain '%s'", domainName))
+}
+
+func (sldds *softLayer_Dns_Domain_Service) PlanRecordSync(dnsId int, desired []datatypes.SoftLayer_Dns_Domain_ResourceRecord) (softlayer.DnsRecordSyncPlan, error) {
+	domain, err := sldds.GetObject(dnsId)
+	if err != nil {
+		return softlayer.DnsRecordSyncPlan{}, err
+	}
+
+	plan := softlayer.DnsRecordSyncPlan{
+		DomainId:   domain.Id,
+		DomainName: domain.Name,
+		Creates:    []datatypes.SoftLayer_Dns_Domain_ResourceRecord{},
+		Updates:    []softlayer.DnsRecordUpdate{},
+		Deletes:    []datatypes.SoftLayer_Dns_Domain_ResourceRecord{},
+	}
+
+	//SoftLayer manages the SOA record of a domain itself, so it is left out of the sync
+	current := map[string][]datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	for _, record := range domain.ResourceRecords {
+		if strings.ToLower(record.Type) == "soa" {
+			continue
+		}
+
+		key := dnsRecordSyncKey(record)
+		current[key] = append(current[key], record)
+	}
+
+	planned := map[string]bool{}
+	for _, record := range desired {
+		if strings.ToLower(record.Type) == "soa" {
+			continue
+		}
+
+		key := dnsRecordSyncKey(record)
+		if planned[key] {
+			return softlayer.DnsRecordSyncPlan{}, errors.New(fmt.Sprintf("softlayer-go: desired DNS records contain '%s' more than once", common.FormatZoneRecord(record)))
+		}
+		planned[key] = true
+
+		matches := current[key]
+		if len(matches) == 0 {
+			record.Id = 0
+			record.DomainId = domain.Id
+			plan.Creates = append(plan.Creates, record)
+			continue
+		}
+
+		existing := matches[0]
+		current[key] = matches[1:]
+
+		if dnsRecordSettingsDiffer(existing, record) {
+			updated := existing
+			if record.Ttl > 0 {
+				updated.Ttl = record.Ttl
+			}
+			updated.MxPriority = record.MxPriority
+			updated.Priority = record.Priority
+			updated.Weight = record.Weight
+			updated.Port = record.Port
+
+			plan.Updates = append(plan.Updates, softlayer.DnsRecordUpdate{Current: existing, Desired: updated})
+		}
+	}
+
+	//Deletes keep the order SoftLayer returned the records in, so that plans are stable
+	for _, record := range domain.ResourceRecords {
+		key := dnsRecordSyncKey(record)
+		remaining := current[key]
+		if len(remaining) > 0 && remaining[0].Id == record.Id {
+			plan.Deletes = append(plan.Deletes, record)
+			current[key] = remaining[1:]
+		}
+	}
+
+	return plan, nil
+}
+
+func (sldds *softLayer_Dns_Domain_Service) RenderRecordSyncPlan(plan softlayer.DnsRecordSyncPlan) string {
+	buffer := new(bytes.Buffer)
+
+	fmt.Fprintf(buffer, "DNS record sync for %s (%d): %d to create, %d to update, %d to delete\n", plan.DomainName, plan.DomainId, len(plan.Creates), len(plan.Updates), len(plan.Deletes))
+
+	for _, record := range plan.Creates {
+		fmt.Fprintf(buffer, "+ %s\n", common.FormatZoneRecord(record))
+	}
+
+	for _, update := range plan.Updates {
+		fmt.Fprintf(buffer, "~ %s\n", common.FormatZoneRecord(update.Desired))
+		for _, change := range dnsRecordSettingsChanges(update.Current, update.Desired) {
+			fmt.Fprintf(buffer, "    %s\n", change)
+		}
+	}
+
+	for _, record := range plan.Deletes {
+		fmt.Fprintf(buffer, "- %s\n", common.FormatZoneRecord(record))
+	}
+
+	return buffer.String()
+}
+
+func (sldds *softLayer_Dns_Domain_Service) ApplyRecordSync(plan softlayer.DnsRecordSyncPlan, dryRun bool) (softlayer.DnsRecordSyncResult, error) {
+	result := softlayer.DnsRecordSyncResult{
+		DryRun:   dryRun,
+		Created:  []datatypes.SoftLayer_Dns_Domain_ResourceRecord{},
+		Updated:  []datatypes.SoftLayer_Dns_Domain_ResourceRecord{},
+		Deleted:  []datatypes.SoftLayer_Dns_Domain_ResourceRecord{},
+		Failures: []softlayer.DnsRecordSyncFailure{},
+	}
+
+	if dryRun {
+		result.Created = append(result.Created, plan.Creates...)
+		for _, update := range plan.Updates {
+			result.Updated = append(result.Updated, update.Desired)
+		}
+		result.Deleted = append(result.Deleted, plan.Deletes...)
+
+		return result, nil
+	}
+
+	resourceRecordService, err := sldds.client.GetSoftLayer_Dns_Domain_ResourceRecord_Service()
+	if err != nil {
+		return result, err
+	}
+
+	//Records are created before obsolete ones are removed so that names keep resolving while the sync runs
+	for _, record := range plan.Creates {
+		template := datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template(record)
+		template.DomainId = plan.DomainId
+
+		created, err := resourceRecordService.CreateObject(template)
+		if err != nil {
+			result.Failures = append(result.Failures, softlayer.DnsRecordSyncFailure{Action: "create", Record: record, Error: err})
+			continue
+		}
+
+		result.Created = append(result.Created, created)
+	}
+
+	for _, update := range plan.Updates {
+		_, err := resourceRecordService.EditObject(update.Current.Id, update.Desired)
+		if err != nil {
+			result.Failures = append(result.Failures, softlayer.DnsRecordSyncFailure{Action: "update", Record: update.Desired, Error: err})
+			continue
+		}
+
+		result.Updated = append(result.Updated, update.Desired)
+	}
+
+	for _, record := range plan.Deletes {
+		_, err := resourceRecordService.DeleteObject(record.Id)
+		if err != nil {
+			result.Failures = append(result.Failures, softlayer.DnsRecordSyncFailure{Action: "delete", Record: record, Error: err})
+			continue
+		}
+
+		result.Deleted = append(result.Deleted, record)
+	}
+
+	if len(result.Failures) > 0 {
+		total := len(plan.Creates) + len(plan.Updates) + len(plan.Deletes)
+		messages := []string{}
+		for _, failure := range result.Failures {
+			messages = append(messages, fmt.Sprintf("%s %s: %s", failure.Action, common.FormatZoneRecord(failure.Record), failure.Error.Error()))
+		}
+
+		return result, errors.New(fmt.Sprintf("softlayer-go: %d of %d DNS record changes failed for domain '%s': %s", len(result.Failures), total, plan.DomainName, strings.Join(messages, "; ")))
+	}
+
+	return result, nil
+}
+
+//Private methods
+
+func resourceRecordsObjectFilter(filter softlayer.DnsResourceRecordFilter) (string, error) {
+	//Records are ordered by id so that pages do not overlap
+	resourceRecords := map[string]interface{}{
+		"id": map[string]interface{}{
+			"operation": "orderBy",
+			"options": []map[string]interface{}{
+				map[string]interface{}{"name": "sort", "value": []string{"ASC"}},
+			},
+		},
+	}
+
+	if filter.Host != "" {
+		resourceRecords["host"] = map[string]string{"operation": filter.Host}
+	}
+
+	if filter.Type != "" {
+		resourceRecords["type"] = map[string]string{"operation": strings.ToLower(filter.Type)}
+	}
+
+	if filter.Data != "" {
+		resourceRecords["data"] = map[string]string{"operation": filter.Data}
+	}
+
+	objectFilter, err := json.Marshal(map[string]interface{}{"resourceRecords": resourceRecords})
+	if err != nil {
+		return "", err
+	}
+
+	//The HTTP client does not encode filters, while record data may contain spaces and quotes
+	return url.QueryEscape(string(objectFilter)), nil
+}
+
+func dnsRecordSyncKey(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) string {
+	recordType := strings.ToLower(record.Type)
+
+	host := strings.ToLower(record.Host)
+	if host == "" {
+		host = "@"
+	}
+
+	data := record.Data
+	switch recordType {
+	case "cname", "ns", "ptr", "mx", "srv":
+		data = strings.TrimSuffix(strings.ToLower(data), ".")
+	}
+
+	if recordType == "srv" {
+		host = strings.ToLower(fmt.Sprintf("%s.%s.%s", record.Service, record.Protocol, host))
+	}
+
+	return strings.Join([]string{host, recordType, data}, "\x00")
+}
+
+func dnsRecordSettingsDiffer(current datatypes.SoftLayer_Dns_Domain_ResourceRecord, desired datatypes.SoftLayer_Dns_Domain_ResourceRecord) bool {
+	return (desired.Ttl > 0 && desired.Ttl != current.Ttl) ||
+		desired.MxPriority != current.MxPriority ||
+		desired.Priority != current.Priority ||
+		desired.Weight != current.Weight ||
+		desired.Port != current.Port
+}
+
+func dnsRecordSettingsChanges(current datatypes.SoftLayer_Dns_Domain_ResourceRecord, desired datatypes.SoftLayer_Dns_Domain_ResourceRecord) []string {
+	changes := []string{}
+
+	settings := []struct {
+		name    string
+		current int
+		desired int
+	}{
+		{"ttl", current.Ttl, desired.Ttl},
+		{"mxPriority", current.MxPriority, desired.MxPriority},
+		{"priority", current.Priority, desired.Priority},
+		{"weight", current.Weight, desired.Weight},
+		{"port", current.Port, desired.Port},
+	}
+
+	for _, setting := range settings {
+		if setting.current != setting.desired {
+			changes = append(changes, fmt.Sprintf("%s: %d -> %d", setting.name, setting.current, setting.desired))
+		}
+	}
+
+	return changes
+}
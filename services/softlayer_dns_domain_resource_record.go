@@ -152,10 +152,14 @@ func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) EditObject(recordId int
 func (sldr *SoftLayer_Dns_Domain_ResourceRecord_Service) getNameByType(dnsType string) string {
 	switch dnsType {
 	case "srv":
-		// Currently only SRV record type requires additional fields for Create and Update, while all other record types
-		// use basic default resource type. Therefore there is no need for now to implement each resource type as separate service
+		// SRV records carry additional fields for Create and Update, which only their own resource type accepts.
+		// Callers who want type specific validation use the typed services of SoftLayer_Dns_Domain_ResourceRecord_Type_Service
 		// https://sldn.softlayer.com/reference/datatypes/SoftLayer_Dns_Domain_ResourceRecord_SrvType
 		return "SoftLayer_Dns_Domain_ResourceRecord_SrvType"
+	case "ptr":
+		// PTR records live in reverse zones and are edited through their own resource type
+		// https://sldn.softlayer.com/reference/datatypes/SoftLayer_Dns_Domain_ResourceRecord_PtrType
+		return "SoftLayer_Dns_Domain_ResourceRecord_PtrType"
 	default:
 		return "SoftLayer_Dns_Domain_ResourceRecord"
 	}
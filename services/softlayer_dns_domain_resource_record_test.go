@@ -209,4 +209,15 @@ var _ = Describe("SoftLayer_Dns_Domain_Record", func() {
 			})
 		})
 	})
+
+	Context("#EditObject with a PTR record", func() {
+		It("edits the record through the PTR resource type", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			edited, err := dnsDomainResourceRecordService.EditObject(556677, datatypes.SoftLayer_Dns_Domain_ResourceRecord{Type: "ptr", Data: "mx.example.com."})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_PtrType/556677/editObject.json"))
+		})
+	})
 })
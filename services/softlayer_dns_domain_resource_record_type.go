@@ -0,0 +1,201 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+//Record types that have a SoftLayer_Dns_Domain_ResourceRecord_*Type service
+var DNS_RESOURCE_RECORD_TYPES = []string{"a", "aaaa", "cname", "mx", "ns", "ptr", "spf", "txt", "srv"}
+
+var dnsResourceRecordTypeNames = map[string]string{
+	"a":     "AType",
+	"aaaa":  "AaaaType",
+	"cname": "CnameType",
+	"mx":    "MxType",
+	"ns":    "NsType",
+	"ptr":   "PtrType",
+	"spf":   "SpfType",
+	"txt":   "TxtType",
+	"srv":   "SrvType",
+}
+
+type softLayer_Dns_Domain_ResourceRecord_Type_Service struct {
+	client     softlayer.Client
+	recordType string
+}
+
+func NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service(client softlayer.Client, recordType string) (*softLayer_Dns_Domain_ResourceRecord_Type_Service, error) {
+	recordType = strings.ToLower(recordType)
+	if _, found := dnsResourceRecordTypeNames[recordType]; !found {
+		return nil, errors.New(fmt.Sprintf("softlayer-go: unsupported DNS record type '%s'", recordType))
+	}
+
+	return &softLayer_Dns_Domain_ResourceRecord_Type_Service{
+		client:     client,
+		recordType: recordType,
+	}, nil
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) GetName() string {
+	return "SoftLayer_Dns_Domain_ResourceRecord_" + dnsResourceRecordTypeNames[sldrts.recordType]
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) GetRecordType() string {
+	return sldrts.recordType
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) CreateObject(template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	record, err := sldrts.checkRecord(datatypes.SoftLayer_Dns_Domain_ResourceRecord(template))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	parameters := datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template_Parameters{
+		Parameters: []datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template{
+			datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template(record),
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	response, errorCode, err := sldrts.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/createObject.json", sldrts.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not %s#createObject, HTTP error code: '%d'", sldrts.GetName(), errorCode)
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+	}
+
+	err = sldrts.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	dns_record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	err = json.Unmarshal(response, &dns_record)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	return dns_record, nil
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) GetObject(recordId int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	objectMask := []string{
+		"data",
+		"domainId",
+		"host",
+		"id",
+		"mxPriority",
+		"ttl",
+		"type",
+		"service",
+		"priority",
+		"protocol",
+		"port",
+		"weight",
+	}
+
+	response, errorCode, err := sldrts.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", sldrts.GetName(), recordId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not %s#getObject, HTTP error code: '%d'", sldrts.GetName(), errorCode)
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(errorMessage)
+	}
+
+	dns_record := datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+	err = json.Unmarshal(response, &dns_record)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	return dns_record, nil
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) DeleteObject(recordId int) (bool, error) {
+	response, errorCode, err := sldrts.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", sldrts.GetName(), recordId), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not %s#deleteObject, HTTP error code: '%d'", sldrts.GetName(), errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete DNS Domain Record with id '%d', got '%s' as response from the API.", recordId, res))
+	}
+
+	return true, nil
+}
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) EditObject(recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error) {
+	record, err := sldrts.checkRecord(template)
+	if err != nil {
+		return false, err
+	}
+
+	parameters := datatypes.SoftLayer_Dns_Domain_ResourceRecord_Parameters{
+		Parameters: []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+			record,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := sldrts.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", sldrts.GetName(), recordId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not %s#editObject, HTTP error code: '%d'", sldrts.GetName(), errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit DNS Domain Record with id: %d, got '%s' as response from the API.", recordId, res))
+	}
+
+	return true, nil
+}
+
+//Private methods
+
+func (sldrts *softLayer_Dns_Domain_ResourceRecord_Type_Service) checkRecord(record datatypes.SoftLayer_Dns_Domain_ResourceRecord) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error) {
+	if record.Type == "" {
+		record.Type = sldrts.recordType
+	}
+
+	if strings.ToLower(record.Type) != sldrts.recordType {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, errors.New(fmt.Sprintf("softlayer-go: %s only accepts %s records, got '%s'", sldrts.GetName(), sldrts.recordType, record.Type))
+	}
+	record.Type = sldrts.recordType
+
+	err := common.ValidateDnsResourceRecord(record)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Domain_ResourceRecord{}, err
+	}
+
+	return record, nil
+}
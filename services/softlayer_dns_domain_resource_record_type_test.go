@@ -0,0 +1,281 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Dns_Domain_ResourceRecord_Type", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		aTypeService   softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+		srvTypeService softlayer.SoftLayer_Dns_Domain_ResourceRecord_Type_Service
+		err            error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		aTypeService, err = fakeClient.GetSoftLayer_Dns_Domain_ResourceRecord_AType_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(aTypeService).ToNot(BeNil())
+
+		srvTypeService, err = fakeClient.GetSoftLayer_Dns_Domain_ResourceRecord_SrvType_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(srvTypeService).ToNot(BeNil())
+	})
+
+	Context("#NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service", func() {
+		It("accepts the known record types regardless of case", func() {
+			mxTypeService, err := services.NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service(fakeClient, "MX")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mxTypeService.GetName()).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_MxType"))
+			Expect(mxTypeService.GetRecordType()).To(Equal("mx"))
+		})
+
+		It("accepts every record type of DNS_RESOURCE_RECORD_TYPES", func() {
+			for _, recordType := range services.DNS_RESOURCE_RECORD_TYPES {
+				_, err := services.NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service(fakeClient, recordType)
+				Expect(err).ToNot(HaveOccurred())
+			}
+		})
+
+		It("fails for unknown record types", func() {
+			_, err := services.NewSoftLayer_Dns_Domain_ResourceRecord_Type_Service(fakeClient, "soa")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#GetName", func() {
+		It("returns the name of the SoftLayer service for the record type", func() {
+			Expect(aTypeService.GetName()).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_AType"))
+			Expect(srvTypeService.GetName()).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_SrvType"))
+
+			aaaaTypeService, err := fakeClient.GetSoftLayer_Dns_Domain_ResourceRecord_AaaaType_Service()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(aaaaTypeService.GetName()).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_AaaaType"))
+		})
+	})
+
+	Context("#CreateObject", func() {
+		var template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template
+
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_ResourceRecord_AType_Service_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			template = common.NewDnsARecord(1234567, "www", "169.45.2.20", 3600)
+		})
+
+		It("creates the record through the service of its type", func() {
+			record, err := aTypeService.CreateObject(template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.Id).To(Equal(101))
+			Expect(record.Type).To(Equal("a"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_AType/createObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("POST"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"data":"169.45.2.20"`))
+		})
+
+		It("fills in the type of records without one", func() {
+			template.Type = ""
+
+			_, err := aTypeService.CreateObject(template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"type":"a"`))
+		})
+
+		It("fails for records of another type without calling the API", func() {
+			_, err := aTypeService.CreateObject(common.NewDnsAaaaRecord(1234567, "www", "2607:f0d0:1002:51::4", 3600))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("only accepts a records"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("fails for invalid records without calling the API", func() {
+			template.Data = "2607:f0d0:1002:51::4"
+
+			_, err := aTypeService.CreateObject(template)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := aTypeService.CreateObject(template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := aTypeService.CreateObject(template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_ResourceRecord_SrvType_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the record", func() {
+			record, err := srvTypeService.GetObject(106)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.Service).To(Equal("_sip"))
+			Expect(record.Protocol).To(Equal("_tcp"))
+			Expect(record.Port).To(Equal(5060))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_SrvType/106/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := srvTypeService.GetObject(106)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := srvTypeService.GetObject(106)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		var record datatypes.SoftLayer_Dns_Domain_ResourceRecord
+
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			record = datatypes.SoftLayer_Dns_Domain_ResourceRecord(common.NewDnsSrvRecord(1234567, "_sip", "_tcp", "voip", "sip.example.com", 10, 60, 5061, 7200))
+			record.Id = 106
+		})
+
+		It("edits the record through the service of its type", func() {
+			edited, err := srvTypeService.EditObject(106, record)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_SrvType/106/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"port":5061`))
+		})
+
+		It("fails for invalid records without calling the API", func() {
+			record.Protocol = "tcp"
+
+			_, err := srvTypeService.EditObject(106, record)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := srvTypeService.EditObject(106, record)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := srvTypeService.EditObject(106, record)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := srvTypeService.EditObject(106, record)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DeleteObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("deletes the record", func() {
+			deleted, err := aTypeService.DeleteObject(101)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord_AType/101.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("DELETE"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := aTypeService.DeleteObject(101)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := aTypeService.DeleteObject(101)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := aTypeService.DeleteObject(101)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
@@ -1,13 +1,19 @@
 package services_test
 
 import (
+	"encoding/json"
+	"fmt"
+	"net/url"
 	"os"
+	"strings"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
 	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
+	services "github.com/maximilien/softlayer-go/services"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
 	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
 )
@@ -119,4 +125,508 @@ var _ = Describe("SoftLayer_Dns_Domain", func() {
 			})
 		})
 	})
+
+	Context("#CreatePtrRecord", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_createPtrRecord.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a PTR record for the IP address", func() {
+			record, err := dnsDomainService.CreatePtrRecord("169.45.12.34", "mail.example.com.", 86400)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(record.Id).To(Equal(556677))
+			Expect(record.Type).To(Equal("ptr"))
+			Expect(record.Data).To(Equal("mail.example.com."))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain/createPtrRecord.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["169.45.12.34","mail.example.com.",86400]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.CreatePtrRecord("169.45.12.34", "mail.example.com.", 86400)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.CreatePtrRecord("169.45.12.34", "mail.example.com.", 86400)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetZoneFileContents", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_getZoneFileContents.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the zone file of the domain", func() {
+			zoneFile, err := dnsDomainService.GetZoneFileContents(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zoneFile).To(HavePrefix("$ORIGIN example.com.\n$TTL 86400\n"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain/1234567/getZoneFileContents.json"))
+		})
+
+		It("returns contents that can be parsed into a domain template", func() {
+			zoneFile, err := dnsDomainService.GetZoneFileContents(1234567)
+			Expect(err).ToNot(HaveOccurred())
+
+			template, err := common.ParseZoneFile(zoneFile, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(template.Name).To(Equal("example.com"))
+			Expect(template.ResourceRecords).To(HaveLen(5))
+			Expect(template.ResourceRecords[0].Retry).To(Equal(300))
+			Expect(template.ResourceRecords[4].MxPriority).To(Equal(10))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetZoneFileContents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetZoneFileContents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ExportZone", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_getZoneFileContents.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the zone file of the domain as served by SoftLayer", func() {
+			zoneFile, err := dnsDomainService.ExportZone(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(zoneFile).To(HavePrefix("$ORIGIN example.com.\n$TTL 86400\n"))
+			Expect(zoneFile).To(ContainSubstring("www\t3600\tIN\tA\t169.45.2.20\n"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain/1234567/getZoneFileContents.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.ExportZone(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.ExportZone(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ImportZone", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the domain with the records of the zone file", func() {
+			zoneFile, err := testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_getZoneFileContents.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			contents := ""
+			Expect(json.Unmarshal(zoneFile, &contents)).To(Succeed())
+
+			domain, err := dnsDomainService.ImportZone(contents)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(domain.Name).To(Equal("qwerty123ff.com"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain.json"))
+
+			requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+			Expect(requestBody).To(ContainSubstring(`"name":"example.com"`))
+			Expect(requestBody).To(ContainSubstring(`"host":"www"`))
+			Expect(requestBody).ToNot(ContainSubstring(`"type":"soa"`))
+			Expect(requestBody).ToNot(ContainSubstring(`"type":"ns"`))
+		})
+
+		It("keeps NS records delegating a subdomain", func() {
+			_, err := dnsDomainService.ImportZone("$ORIGIN example.com.\ndev IN NS ns1.dev.example.com.")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"host":"dev"`))
+		})
+
+		It("fails for invalid zone files without calling the API", func() {
+			_, err := dnsDomainService.ImportZone("www IN A 10.0.0.1")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.ImportZone("$ORIGIN example.com.\nwww IN A 10.0.0.1")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.ImportZone("$ORIGIN example.com.\nwww IN A 10.0.0.1")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetResourceRecords", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_getResourceRecords.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns a page of the records matching the filter", func() {
+			records, err := dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{Host: "www", Type: "A"}, 100, 50)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+			Expect(records[1].Id).To(Equal(107))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Dns_Domain/1234567/getResourceRecords.json?resultLimit=100,50"))
+
+			filter, err := url.QueryUnescape(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter).To(MatchJSON(`{"resourceRecords":{"id":{"operation":"orderBy","options":[{"name":"sort","value":["ASC"]}]},"host":{"operation":"www"},"type":{"operation":"a"}}}`))
+		})
+
+		It("encodes data filters with spaces and quotes", func() {
+			_, err := dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{Data: `v=spf1 "-all"`}, 0, 50)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).ToNot(ContainSubstring(" "))
+
+			filter, err := url.QueryUnescape(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(filter).To(ContainSubstring(`"data":{"operation":"v=spf1 \"-all\""}`))
+		})
+
+		It("fails for invalid result limits without calling the API", func() {
+			_, err := dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{}, -1, 50)
+			Expect(err).To(HaveOccurred())
+
+			_, err = dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{}, 0, 0)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{}, 0, 50)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetResourceRecords(1234567, softlayer.DnsResourceRecordFilter{}, 0, 50)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetAllResourceRecords", func() {
+		BeforeEach(func() {
+			fullPage := []datatypes.SoftLayer_Dns_Domain_ResourceRecord{}
+			for i := 0; i < services.DNS_RESOURCE_RECORDS_PAGE_SIZE; i++ {
+				fullPage = append(fullPage, datatypes.SoftLayer_Dns_Domain_ResourceRecord{Id: i + 1, Host: "www", Type: "a"})
+			}
+
+			response, err := json.Marshal(fullPage)
+			Expect(err).ToNot(HaveOccurred())
+
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{response}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Dns_Domain_getResourceRecords.json"})
+		})
+
+		It("fetches pages until a page is not full", func() {
+			records, err := dnsDomainService.GetAllResourceRecords(1234567, softlayer.DnsResourceRecordFilter{Host: "www"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(services.DNS_RESOURCE_RECORDS_PAGE_SIZE + 2))
+			Expect(records[services.DNS_RESOURCE_RECORDS_PAGE_SIZE].Id).To(Equal(101))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal(fmt.Sprintf("SoftLayer_Dns_Domain/1234567/getResourceRecords.json?resultLimit=%d,%d", services.DNS_RESOURCE_RECORDS_PAGE_SIZE, services.DNS_RESOURCE_RECORDS_PAGE_SIZE)))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetAllResourceRecords(1234567, softlayer.DnsResourceRecordFilter{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.GetAllResourceRecords(1234567, softlayer.DnsResourceRecordFilter{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#FindRecords", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Dns_Domain_getByDomainName.json",
+				"SoftLayer_Dns_Domain_getResourceRecords.json",
+			})
+		})
+
+		It("returns the records of the domain with the exact name", func() {
+			records, err := dnsDomainService.FindRecords("mql.services.dal.bluemix.net.", "www", "a")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(records).To(HaveLen(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain/getByDomainName/mql.services.dal.bluemix.net."))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(HavePrefix("SoftLayer_Dns_Domain/1666930/getResourceRecords.json"))
+		})
+
+		It("fails when no domain has the exact name", func() {
+			_, err := dnsDomainService.FindRecords("dal.bluemix.net", "www", "a")
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("could not find DNS domain 'dal.bluemix.net'"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.FindRecords("mql.services.dal.bluemix.net", "www", "a")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.FindRecords("mql.services.dal.bluemix.net", "www", "a")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlanRecordSync", func() {
+		var desired []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Domain_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			desired = []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "ns1.softlayer.com.", ResponsiblePerson: "admin.example.com.", Ttl: 3600, Type: "soa"},
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "www", Data: "169.45.2.20", Ttl: 300, Type: "a"},
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "ns1.softlayer.com", Ttl: 86400, Type: "ns"},
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "@", Data: "mail.example.com.", MxPriority: 10, Ttl: 3600, Type: "mx"},
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "api", Data: "10.0.0.2", Ttl: 600, Type: "a"},
+			}
+		})
+
+		It("plans creates, updates and deletes matching records on host, type and data", func() {
+			plan, err := dnsDomainService.PlanRecordSync(1234567, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Dns_Domain/1234567/getObject.json"))
+
+			Expect(plan.DomainId).To(Equal(1234567))
+			Expect(plan.DomainName).To(Equal("example.com"))
+
+			Expect(plan.Creates).To(Equal([]datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+				datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "api", Data: "10.0.0.2", DomainId: 1234567, Ttl: 600, Type: "a"},
+			}))
+
+			Expect(plan.Updates).To(HaveLen(1))
+			Expect(plan.Updates[0].Current.Ttl).To(Equal(3600))
+			Expect(plan.Updates[0].Desired).To(Equal(datatypes.SoftLayer_Dns_Domain_ResourceRecord{Id: 101, DomainId: 1234567, Host: "www", Data: "169.45.2.20", Ttl: 300, Type: "a"}))
+
+			Expect(plan.Deletes).To(HaveLen(1))
+			Expect(plan.Deletes[0].Id).To(Equal(105))
+		})
+
+		It("plans a delete and a create when the data of a record changes", func() {
+			desired[1].Data = "169.45.2.21"
+
+			plan, err := dnsDomainService.PlanRecordSync(1234567, desired)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(plan.Creates).To(HaveLen(2))
+			Expect(plan.Creates[0].Data).To(Equal("169.45.2.21"))
+			Expect(plan.Updates).To(BeEmpty())
+			Expect(plan.Deletes).To(HaveLen(2))
+			Expect(plan.Deletes[0].Id).To(Equal(101))
+		})
+
+		It("fails for duplicate desired records", func() {
+			desired = append(desired, desired[1])
+
+			_, err := dnsDomainService.PlanRecordSync(1234567, desired)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("more than once"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.PlanRecordSync(1234567, desired)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsDomainService.PlanRecordSync(1234567, desired)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("record sync plans", func() {
+		var plan softlayer.DnsRecordSyncPlan
+
+		BeforeEach(func() {
+			plan = softlayer.DnsRecordSyncPlan{
+				DomainId:   1234567,
+				DomainName: "example.com",
+				Creates: []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Host: "api", Data: "10.0.0.2", Ttl: 600, Type: "a"},
+				},
+				Updates: []softlayer.DnsRecordUpdate{
+					softlayer.DnsRecordUpdate{
+						Current: datatypes.SoftLayer_Dns_Domain_ResourceRecord{Id: 101, Host: "www", Data: "169.45.2.20", Ttl: 3600, Type: "a"},
+						Desired: datatypes.SoftLayer_Dns_Domain_ResourceRecord{Id: 101, Host: "www", Data: "169.45.2.20", Ttl: 300, Type: "a"},
+					},
+				},
+				Deletes: []datatypes.SoftLayer_Dns_Domain_ResourceRecord{
+					datatypes.SoftLayer_Dns_Domain_ResourceRecord{Id: 105, Host: "@", Data: "v=spf1 -all", Ttl: 3600, Type: "txt"},
+				},
+			}
+
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+		})
+
+		Context("#RenderRecordSyncPlan", func() {
+			It("renders every change as a zone file line", func() {
+				Expect(dnsDomainService.RenderRecordSyncPlan(plan)).To(Equal(strings.Join([]string{
+					"DNS record sync for example.com (1234567): 1 to create, 1 to update, 1 to delete",
+					"+ api\t600\tIN\tA\t10.0.0.2",
+					"~ www\t300\tIN\tA\t169.45.2.20",
+					"    ttl: 3600 -> 300",
+					"- @\t3600\tIN\tTXT\t\"v=spf1 -all\"",
+					"",
+				}, "\n")))
+			})
+		})
+
+		Context("#ApplyRecordSync", func() {
+			It("reports the planned changes without calling the API in dry-run mode", func() {
+				result, err := dnsDomainService.ApplyRecordSync(plan, true)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.DryRun).To(BeTrue())
+				Expect(result.Created).To(Equal(plan.Creates))
+				Expect(result.Updated).To(Equal([]datatypes.SoftLayer_Dns_Domain_ResourceRecord{plan.Updates[0].Desired}))
+				Expect(result.Deleted).To(Equal(plan.Deletes))
+				Expect(result.Failures).To(BeEmpty())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+			})
+
+			It("creates, updates and deletes the planned records", func() {
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Dns_Domain_ResourceRecord_Service_createObject.json"})
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"), []byte("true"))
+
+				result, err := dnsDomainService.ApplyRecordSync(plan, false)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(result.Created).To(HaveLen(1))
+				Expect(result.Created[0].Id).To(Equal(111))
+				Expect(result.Updated).To(HaveLen(1))
+				Expect(result.Deleted).To(HaveLen(1))
+				Expect(result.Failures).To(BeEmpty())
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(3))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Domain_ResourceRecord/105.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("DELETE"))
+			})
+
+			It("keeps applying changes and reports the ones that failed", func() {
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Dns_Domain_ResourceRecord_Service_createObject.json"})
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("false"), []byte("true"))
+
+				result, err := dnsDomainService.ApplyRecordSync(plan, false)
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(ContainSubstring("1 of 3 DNS record changes failed for domain 'example.com'"))
+
+				Expect(result.Created).To(HaveLen(1))
+				Expect(result.Updated).To(BeEmpty())
+				Expect(result.Deleted).To(HaveLen(1))
+				Expect(result.Failures).To(HaveLen(1))
+				Expect(result.Failures[0].Action).To(Equal("update"))
+				Expect(result.Failures[0].Record.Id).To(Equal(101))
+			})
+		})
+	})
 })
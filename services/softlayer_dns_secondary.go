@@ -0,0 +1,235 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Dns_Secondary_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Dns_Secondary_Service(client softlayer.Client) *softLayer_Dns_Secondary_Service {
+	return &softLayer_Dns_Secondary_Service{
+		client: client,
+	}
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) GetName() string {
+	return "SoftLayer_Dns_Secondary"
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) CreateObject(template datatypes.SoftLayer_Dns_Secondary_Template) (datatypes.SoftLayer_Dns_Secondary, error) {
+	if template.ZoneName == "" {
+		return datatypes.SoftLayer_Dns_Secondary{}, errors.New("softlayer-go: secondary DNS zones require a zone name")
+	}
+
+	err := sldss.checkTemplate(template, true)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	parameters := datatypes.SoftLayer_Dns_Secondary_Template_Parameters{
+		Parameters: []datatypes.SoftLayer_Dns_Secondary_Template{
+			template,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", sldss.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#createObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Dns_Secondary{}, errors.New(errorMessage)
+	}
+
+	err = sldss.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	secondary := datatypes.SoftLayer_Dns_Secondary{}
+	err = json.Unmarshal(response, &secondary)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	return secondary, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) GetObject(secondaryId int) (datatypes.SoftLayer_Dns_Secondary, error) {
+	objectMask := []string{
+		"id",
+		"zoneName",
+		"masterIpAddress",
+		"transferFrequency",
+		"statusId",
+		"statusText",
+		"createDate",
+		"lastUpdate",
+	}
+
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", sldss.GetName(), secondaryId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Dns_Secondary{}, errors.New(errorMessage)
+	}
+
+	secondary := datatypes.SoftLayer_Dns_Secondary{}
+	err = json.Unmarshal(response, &secondary)
+	if err != nil {
+		return datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	return secondary, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) GetByDomainName(name string) ([]datatypes.SoftLayer_Dns_Secondary, error) {
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/getByDomainName/%s", sldss.GetName(), name), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#getByDomainName, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Dns_Secondary{}, errors.New(errorMessage)
+	}
+
+	secondaries := []datatypes.SoftLayer_Dns_Secondary{}
+	err = json.Unmarshal(response, &secondaries)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Secondary{}, err
+	}
+
+	return secondaries, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) EditObject(secondaryId int, template datatypes.SoftLayer_Dns_Secondary_Template) (bool, error) {
+	if template.ZoneName != "" {
+		return false, errors.New("softlayer-go: the zone name of secondary DNS zones cannot be changed")
+	}
+
+	if template.MasterIpAddress == "" && template.TransferFrequency == 0 {
+		return false, errors.New("softlayer-go: a master IP address or transfer frequency is required to edit secondary DNS zones")
+	}
+
+	err := sldss.checkTemplate(template, false)
+	if err != nil {
+		return false, err
+	}
+
+	parameters := datatypes.SoftLayer_Dns_Secondary_Template_Parameters{
+		Parameters: []datatypes.SoftLayer_Dns_Secondary_Template{
+			template,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", sldss.GetName(), secondaryId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit secondary DNS zone with id '%d', got '%s' as response from the API.", secondaryId, res))
+	}
+
+	return true, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) DeleteObject(secondaryId int) (bool, error) {
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", sldss.GetName(), secondaryId), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#deleteObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete secondary DNS zone with id '%d', got '%s' as response from the API.", secondaryId, res))
+	}
+
+	return true, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) TransferNow(secondaryId int) (bool, error) {
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/transferNow.json", sldss.GetName(), secondaryId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#transferNow, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to transfer secondary DNS zone with id '%d', got '%s' as response from the API.", secondaryId, res))
+	}
+
+	return true, nil
+}
+
+func (sldss *softLayer_Dns_Secondary_Service) GetErrorMessages(secondaryId int) ([]datatypes.SoftLayer_Dns_Message, error) {
+	response, errorCode, err := sldss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getErrorMessages.json", sldss.GetName(), secondaryId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Message{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Dns_Secondary#getErrorMessages, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Dns_Message{}, errors.New(errorMessage)
+	}
+
+	messages := []datatypes.SoftLayer_Dns_Message{}
+	err = json.Unmarshal(response, &messages)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Message{}, err
+	}
+
+	return messages, nil
+}
+
+//Private methods
+
+func (sldss *softLayer_Dns_Secondary_Service) checkTemplate(template datatypes.SoftLayer_Dns_Secondary_Template, required bool) error {
+	if (required || template.MasterIpAddress != "") && net.ParseIP(template.MasterIpAddress) == nil {
+		return errors.New(fmt.Sprintf("softlayer-go: invalid master IP address '%s' for secondary DNS zone", template.MasterIpAddress))
+	}
+
+	if (required || template.TransferFrequency != 0) && template.TransferFrequency < 1 {
+		return errors.New(fmt.Sprintf("softlayer-go: transfer frequency of secondary DNS zones must be a positive number of minutes, got '%d'", template.TransferFrequency))
+	}
+
+	return nil
+}
@@ -0,0 +1,393 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Dns_Secondary", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		dnsSecondaryService softlayer.SoftLayer_Dns_Secondary_Service
+		err                 error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		dnsSecondaryService, err = fakeClient.GetSoftLayer_Dns_Secondary_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(dnsSecondaryService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := dnsSecondaryService.GetName()
+			Expect(name).To(Equal("SoftLayer_Dns_Secondary"))
+		})
+	})
+
+	Context("#CreateObject", func() {
+		var template datatypes.SoftLayer_Dns_Secondary_Template
+
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Secondary_Service_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			template = datatypes.SoftLayer_Dns_Secondary_Template{
+				ZoneName:          "example.org",
+				MasterIpAddress:   "203.0.113.10",
+				TransferFrequency: 10,
+			}
+		})
+
+		It("creates the secondary zone", func() {
+			secondary, err := dnsSecondaryService.CreateObject(template)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondary.Id).To(Equal(4001))
+			Expect(secondary.StatusText).To(Equal("Pending Transfer"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("POST"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(MatchJSON(`{"parameters":[{"zoneName":"example.org","masterIpAddress":"203.0.113.10","transferFrequency":10}]}`))
+		})
+
+		It("fails for invalid templates without calling the API", func() {
+			_, err := dnsSecondaryService.CreateObject(datatypes.SoftLayer_Dns_Secondary_Template{MasterIpAddress: "203.0.113.10", TransferFrequency: 10})
+			Expect(err).To(HaveOccurred())
+
+			template.MasterIpAddress = "ns1.example.org"
+			_, err = dnsSecondaryService.CreateObject(template)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid master IP address 'ns1.example.org'"))
+
+			template.MasterIpAddress = "203.0.113.10"
+			template.TransferFrequency = 0
+			_, err = dnsSecondaryService.CreateObject(template)
+			Expect(err).To(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.CreateObject(template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.CreateObject(template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Secondary_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the secondary zone", func() {
+			secondary, err := dnsSecondaryService.GetObject(4001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondary.ZoneName).To(Equal("example.org"))
+			Expect(secondary.MasterIpAddress).To(Equal("203.0.113.10"))
+			Expect(secondary.TransferFrequency).To(Equal(10))
+			Expect(secondary.LastUpdate).To(Equal("2016-03-14T08:00:02-06:00"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Dns_Secondary/4001/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetByDomainName", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Secondary_Service_getByDomainName.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the secondary zones with the name", func() {
+			secondaries, err := dnsSecondaryService.GetByDomainName("example.org")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(secondaries).To(HaveLen(1))
+			Expect(secondaries[0].Id).To(Equal(4001))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary/getByDomainName/example.org"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetByDomainName("example.org")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetByDomainName("example.org")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("changes the master IP address and transfer frequency", func() {
+			edited, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{MasterIpAddress: "203.0.113.20", TransferFrequency: 30})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary/4001/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(MatchJSON(`{"parameters":[{"masterIpAddress":"203.0.113.20","transferFrequency":30}]}`))
+		})
+
+		It("only sends the given fields", func() {
+			_, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{TransferFrequency: 60})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(MatchJSON(`{"parameters":[{"transferFrequency":60}]}`))
+		})
+
+		It("fails for invalid changes without calling the API", func() {
+			_, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{})
+			Expect(err).To(HaveOccurred())
+
+			_, err = dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{ZoneName: "example.net"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{MasterIpAddress: "203.0.113"})
+			Expect(err).To(HaveOccurred())
+
+			_, err = dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{TransferFrequency: -5})
+			Expect(err).To(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{TransferFrequency: 60})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{TransferFrequency: 60})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.EditObject(4001, datatypes.SoftLayer_Dns_Secondary_Template{TransferFrequency: 60})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DeleteObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("deletes the secondary zone", func() {
+			deleted, err := dnsSecondaryService.DeleteObject(4001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary/4001.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("DELETE"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := dnsSecondaryService.DeleteObject(4001)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reports the HTTP error code before the response of the API", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 404
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to find object with id of '4001'.","code":"SoftLayer_Exception_ObjectNotFound"}`)
+
+			_, err := dnsSecondaryService.DeleteObject(4001)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("HTTP error code: '404'"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.DeleteObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.DeleteObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#TransferNow", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("triggers a zone transfer", func() {
+			transferred, err := dnsSecondaryService.TransferNow(4001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(transferred).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary/4001/transferNow.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := dnsSecondaryService.TransferNow(4001)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("reports the HTTP error code before the response of the API", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 404
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to find object with id of '4001'.","code":"SoftLayer_Exception_ObjectNotFound"}`)
+
+			_, err := dnsSecondaryService.TransferNow(4001)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("HTTP error code: '404'"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.TransferNow(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.TransferNow(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetErrorMessages", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Dns_Secondary_Service_getErrorMessages.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the zone transfer errors", func() {
+			messages, err := dnsSecondaryService.GetErrorMessages(4002)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(messages).To(HaveLen(2))
+			Expect(messages[0].SecondaryId).To(Equal(4002))
+			Expect(messages[0].Message).To(ContainSubstring("connection refused"))
+			Expect(messages[1].Type).To(Equal("transfer"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Dns_Secondary/4002/getErrorMessages.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetErrorMessages(4002)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := dnsSecondaryService.GetErrorMessages(4002)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
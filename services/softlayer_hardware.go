@@ -5,10 +5,20 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"strconv"
+	"time"
 
+	boshlog "github.com/cloudfoundry/bosh-utils/logger"
+	boshretry "github.com/cloudfoundry/bosh-utils/retrystrategy"
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	"github.com/pivotal-golang/clock"
+)
+
+const (
+	HARDWARE_SERVER_ORDER_COMPLEX_TYPE = "SoftLayer_Container_Product_Order_Hardware_Server"
 )
 
 type softLayer_Hardware_Service struct {
@@ -443,3 +453,632 @@ func (slhs *softLayer_Hardware_Service) SetTags(instanceId int, tags []string) (
 
 	return true, nil
 }
+
+func (slhs *softLayer_Hardware_Service) GetBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBillingItem.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getBillingItem, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Billing_Item{}, errors.New(errorMessage)
+	}
+
+	billingItem := datatypes.SoftLayer_Billing_Item{}
+	err = json.Unmarshal(response, &billingItem)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	return billingItem, nil
+}
+
+func (slhs *softLayer_Hardware_Service) CancelObject(id int, options *softlayer.CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	billingItem, err := slhs.findBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	cancellationRequestService, err := slhs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return cancellationRequestService.CancelBillingItem(billingItem.Id, options)
+}
+
+func (slhs *softLayer_Hardware_Service) GetPendingCancellationRequest(id int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	billingItem, err := slhs.findBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	cancellationRequestService, err := slhs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return cancellationRequestService.GetPendingCancellationRequest(billingItem.Id)
+}
+
+func (slhs *softLayer_Hardware_Service) ReverseCancellation(id int) (bool, error) {
+	request, err := slhs.GetPendingCancellationRequest(id)
+	if err != nil {
+		return false, err
+	}
+
+	if request.Id == 0 {
+		return false, errors.New(fmt.Sprintf("No pending cancellation request found for hardware with id '%d'", id))
+	}
+
+	cancellationRequestService, err := slhs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return false, err
+	}
+
+	return cancellationRequestService.Void(request.Id, true)
+}
+
+func (slhs *softLayer_Hardware_Service) BuildBareMetalServerOrder(options *softlayer.BareMetalServerOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error) {
+	err := slhs.checkBareMetalServerOrderRequiredValues(options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	productPackageService, err := slhs.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	presetId := 0
+	if options.PresetKeyName != "" {
+		presets, err := productPackageService.GetActivePresets(options.PackageId)
+		if err != nil {
+			return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+		}
+
+		for _, preset := range presets {
+			if preset.KeyName == options.PresetKeyName {
+				presetId = preset.Id
+				break
+			}
+		}
+
+		if presetId == 0 {
+			return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, errors.New(fmt.Sprintf("Failed to find active preset '%s' in package '%d'", options.PresetKeyName, options.PackageId))
+		}
+	}
+
+	prices := []datatypes.SoftLayer_Product_Item_Price{}
+	if len(options.ItemKeyNames) > 0 {
+		itemPrices, err := productPackageService.GetItemPrices(options.PackageId, "")
+		if err != nil {
+			return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+		}
+
+		for _, keyName := range options.ItemKeyNames {
+			price, err := slhs.findItemPriceByKeyName(itemPrices, keyName)
+			if err != nil {
+				return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+			}
+
+			prices = append(prices, datatypes.SoftLayer_Product_Item_Price{Id: price.Id})
+		}
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Hardware_Server{
+		ComplexType: HARDWARE_SERVER_ORDER_COMPLEX_TYPE,
+		Location:    options.Datacenter,
+		PackageId:   options.PackageId,
+		PresetId:    presetId,
+		Prices:      prices,
+		Hardware: []datatypes.Hardware{
+			datatypes.Hardware{
+				Hostname: options.Hostname,
+				Domain:   options.Domain,
+			},
+		},
+		Quantity:         1,
+		UseHourlyPricing: options.HourlyBilling,
+	}
+
+	if len(options.SshKeyIds) > 0 {
+		order.SshKeys = []datatypes.SoftLayer_Container_Product_Order_SshKeys{
+			datatypes.SoftLayer_Container_Product_Order_SshKeys{
+				SshKeyIds: options.SshKeyIds,
+			},
+		}
+	}
+
+	if options.ProvisionScriptUri != "" {
+		order.ProvisionScripts = []string{options.ProvisionScriptUri}
+	}
+
+	return order, nil
+}
+
+func (slhs *softLayer_Hardware_Service) VerifyBareMetalServerOrder(options *softlayer.BareMetalServerOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error) {
+	order, err := slhs.BuildBareMetalServerOrder(options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	productOrderService, err := slhs.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	return productOrderService.VerifyContainerOrderHardwareServer(order)
+}
+
+func (slhs *softLayer_Hardware_Service) OrderBareMetalServer(options *softlayer.BareMetalServerOrderOptions) (datatypes.SoftLayer_Hardware, error) {
+	order, err := slhs.BuildBareMetalServerOrder(options)
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	productOrderService, err := slhs.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	_, err = productOrderService.VerifyContainerOrderHardwareServer(order)
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	receipt, err := productOrderService.PlaceContainerOrderHardwareServer(order)
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	SL_CREATE_BARE_METAL_SERVER_TIMEOUT, err := strconv.Atoi(os.Getenv("SL_CREATE_BARE_METAL_SERVER_TIMEOUT"))
+	if err != nil || SL_CREATE_BARE_METAL_SERVER_TIMEOUT == 0 {
+		SL_CREATE_BARE_METAL_SERVER_TIMEOUT = 14400
+	}
+	SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL, err := strconv.Atoi(os.Getenv("SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL"))
+	if err != nil || SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL == 0 {
+		SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL = 60
+	}
+
+	var hardware datatypes.SoftLayer_Hardware
+	execStmtRetryable := boshretry.NewRetryable(
+		func() (bool, error) {
+			hardware, err = slhs.findHardwareByOrderId(receipt.OrderId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to find hardware with order id `%d` due to `%s`, retrying...", receipt.OrderId, err.Error()))
+			}
+
+			if hardware.ProvisionDate == nil {
+				return true, errors.New(fmt.Sprintf("Hardware with id `%d` is not provisioned yet, retrying...", hardware.Id))
+			}
+
+			return false, nil
+		})
+	timeService := clock.NewClock()
+	timeoutRetryStrategy := boshretry.NewTimeoutRetryStrategy(time.Duration(SL_CREATE_BARE_METAL_SERVER_TIMEOUT)*time.Second, time.Duration(SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL)*time.Second, execStmtRetryable, timeService, boshlog.NewLogger(boshlog.LevelInfo))
+	err = timeoutRetryStrategy.Try()
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, errors.New(fmt.Sprintf("Failed to find provisioned hardware with order id `%d` after retry within `%d` seconds", receipt.OrderId, SL_CREATE_BARE_METAL_SERVER_TIMEOUT))
+	}
+
+	return hardware, nil
+}
+
+func (slhs *softLayer_Hardware_Service) ReloadOperatingSystem(id int, template datatypes.Image_Template_Config) error {
+	return slhs.ConfirmReloadOperatingSystem(id, "FORCE", template)
+}
+
+func (slhs *softLayer_Hardware_Service) GetReloadOperatingSystemToken(id int, template datatypes.Image_Template_Config) (string, error) {
+	response, err := slhs.reloadOperatingSystem(id, "", template)
+	if err != nil {
+		return "", err
+	}
+
+	token := ""
+	err = json.Unmarshal(response, &token)
+	if err != nil {
+		return "", err
+	}
+
+	if token == "" {
+		return "", errors.New(fmt.Sprintf("Failed to get OS reload token for hardware with id '%d', got '%s' as response from the API.", id, string(response[:])))
+	}
+
+	return token, nil
+}
+
+func (slhs *softLayer_Hardware_Service) ConfirmReloadOperatingSystem(id int, token string, template datatypes.Image_Template_Config) error {
+	_, err := slhs.reloadOperatingSystem(id, token, template)
+
+	return err
+}
+
+func (slhs *softLayer_Hardware_Service) BootToRescueLayer(id int, noOsBootEnvironment string) (bool, error) {
+	parameters := datatypes.SoftLayer_Hardware_String_Parameters{
+		Parameters: []string{noOsBootEnvironment},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/bootToRescueLayer.json", slhs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#bootToRescueLayer, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to boot hardware with id '%d' into the rescue layer, got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slhs *softLayer_Hardware_Service) GetRemoteManagementAccounts(id int) ([]datatypes.SoftLayer_Hardware_Component_RemoteManagement_User, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getRemoteManagementAccounts.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Hardware_Component_RemoteManagement_User{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getRemoteManagementAccounts, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Hardware_Component_RemoteManagement_User{}, errors.New(errorMessage)
+	}
+
+	accounts := []datatypes.SoftLayer_Hardware_Component_RemoteManagement_User{}
+	err = json.Unmarshal(response, &accounts)
+	if err != nil {
+		return []datatypes.SoftLayer_Hardware_Component_RemoteManagement_User{}, err
+	}
+
+	return accounts, nil
+}
+
+func (slhs *softLayer_Hardware_Service) GetNetworkManagementIpAddress(id int) (string, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getNetworkManagementIpAddress.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getNetworkManagementIpAddress, HTTP error code: '%d'", errorCode)
+		return "", errors.New(errorMessage)
+	}
+
+	ipAddress := ""
+	err = json.Unmarshal(response, &ipAddress)
+	if err != nil {
+		return "", err
+	}
+
+	return ipAddress, nil
+}
+
+func (slhs *softLayer_Hardware_Service) CreateFirmwareUpdateTransaction(id int, options *softlayer.FirmwareUpdateOptions) (bool, error) {
+	if options == nil {
+		options = &softlayer.FirmwareUpdateOptions{}
+	}
+
+	parameters := []interface{}{
+		slhs.firmwareFlag(options.Ipmi),
+		slhs.firmwareFlag(options.RaidController),
+		slhs.firmwareFlag(options.Bios),
+		slhs.firmwareFlag(options.HardDrive),
+		slhs.firmwareFlag(options.NetworkCard),
+	}
+
+	return slhs.createFirmwareTransaction(id, "createFirmwareUpdateTransaction", parameters)
+}
+
+func (slhs *softLayer_Hardware_Service) CreateFirmwareReflashTransaction(id int, options *softlayer.FirmwareUpdateOptions) (bool, error) {
+	if options == nil {
+		options = &softlayer.FirmwareUpdateOptions{}
+	}
+
+	parameters := []interface{}{
+		slhs.firmwareFlag(options.Ipmi),
+		slhs.firmwareFlag(options.RaidController),
+		slhs.firmwareFlag(options.Bios),
+	}
+
+	return slhs.createFirmwareTransaction(id, "createFirmwareReflashTransaction", parameters)
+}
+
+func (slhs *softLayer_Hardware_Service) GetComponents(id int) ([]datatypes.SoftLayer_Hardware_Component, error) {
+	return slhs.getHardwareComponents(id, "getComponents")
+}
+
+func (slhs *softLayer_Hardware_Service) GetHardDrives(id int) ([]datatypes.SoftLayer_Hardware_Component, error) {
+	return slhs.getHardwareComponents(id, "getHardDrives")
+}
+
+func (slhs *softLayer_Hardware_Service) GetRaidControllers(id int) ([]datatypes.SoftLayer_Hardware_Component, error) {
+	return slhs.getHardwareComponents(id, "getRaidControllers")
+}
+
+func (slhs *softLayer_Hardware_Service) GetNetworkComponents(id int) ([]datatypes.SoftLayer_Network_Component, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getNetworkComponents.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Component{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getNetworkComponents, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Component{}, errors.New(errorMessage)
+	}
+
+	networkComponents := []datatypes.SoftLayer_Network_Component{}
+	err = json.Unmarshal(response, &networkComponents)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Component{}, err
+	}
+
+	return networkComponents, nil
+}
+
+func (slhs *softLayer_Hardware_Service) GetSensorData(id int) ([]datatypes.SoftLayer_Container_RemoteManagement_SensorReading, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getSensorData.json", slhs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Container_RemoteManagement_SensorReading{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#getSensorData, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Container_RemoteManagement_SensorReading{}, errors.New(errorMessage)
+	}
+
+	sensorReadings := []datatypes.SoftLayer_Container_RemoteManagement_SensorReading{}
+	err = json.Unmarshal(response, &sensorReadings)
+	if err != nil {
+		return []datatypes.SoftLayer_Container_RemoteManagement_SensorReading{}, err
+	}
+
+	return sensorReadings, nil
+}
+
+func (slhs *softLayer_Hardware_Service) ActivatePrivatePort(id int) (bool, error) {
+	return slhs.toggleNetworkPort(id, "activatePrivatePort")
+}
+
+func (slhs *softLayer_Hardware_Service) ActivatePublicPort(id int) (bool, error) {
+	return slhs.toggleNetworkPort(id, "activatePublicPort")
+}
+
+func (slhs *softLayer_Hardware_Service) ShutdownPrivatePort(id int) (bool, error) {
+	return slhs.toggleNetworkPort(id, "shutdownPrivatePort")
+}
+
+func (slhs *softLayer_Hardware_Service) ShutdownPublicPort(id int) (bool, error) {
+	return slhs.toggleNetworkPort(id, "shutdownPublicPort")
+}
+
+func (slhs *softLayer_Hardware_Service) SetPrivateNetworkInterfaceSpeed(id int, speed int) (bool, error) {
+	return slhs.setNetworkInterfaceSpeed(id, "setPrivateNetworkInterfaceSpeed", speed)
+}
+
+func (slhs *softLayer_Hardware_Service) SetPublicNetworkInterfaceSpeed(id int, speed int) (bool, error) {
+	return slhs.setNetworkInterfaceSpeed(id, "setPublicNetworkInterfaceSpeed", speed)
+}
+
+//Private methods
+
+func (slhs *softLayer_Hardware_Service) getHardwareComponents(id int, method string) ([]datatypes.SoftLayer_Hardware_Component, error) {
+	objectMask := []string{
+		"id",
+		"hardwareId",
+		"serialNumber",
+		"hardwareComponentModel.id",
+		"hardwareComponentModel.name",
+		"hardwareComponentModel.description",
+		"hardwareComponentModel.manufacturer",
+		"hardwareComponentModel.version",
+		"hardwareComponentModel.hardwareGenericComponentModel.id",
+		"hardwareComponentModel.hardwareGenericComponentModel.capacity",
+		"hardwareComponentModel.hardwareGenericComponentModel.units",
+		"hardwareComponentModel.hardwareGenericComponentModel.description",
+		"hardwareComponentModel.hardwareGenericComponentModel.hardwareComponentType",
+	}
+
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/%s.json", slhs.GetName(), id, method), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Hardware_Component{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#%s, HTTP error code: '%d'", method, errorCode)
+		return []datatypes.SoftLayer_Hardware_Component{}, errors.New(errorMessage)
+	}
+
+	components := []datatypes.SoftLayer_Hardware_Component{}
+	err = json.Unmarshal(response, &components)
+	if err != nil {
+		return []datatypes.SoftLayer_Hardware_Component{}, err
+	}
+
+	return components, nil
+}
+
+func (slhs *softLayer_Hardware_Service) reloadOperatingSystem(id int, token string, template datatypes.Image_Template_Config) ([]byte, error) {
+	parameters := datatypes.SoftLayer_Hardware_InitParameters{
+		Parameters: []interface{}{token, template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slhs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#reloadOperatingSystem, HTTP error code: '%d'", errorCode)
+		return []byte{}, errors.New(errorMessage)
+	}
+
+	return response, nil
+}
+
+func (slhs *softLayer_Hardware_Service) toggleNetworkPort(id int, method string) (bool, error) {
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slhs.GetName(), id, method), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#%s, HTTP error code: '%d'", method, errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to %s for hardware with id '%d', got '%s' as response from the API.", method, id, res))
+	}
+
+	return true, nil
+}
+
+func (slhs *softLayer_Hardware_Service) setNetworkInterfaceSpeed(id int, method string, speed int) (bool, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Hardware_InitParameters{Parameters: []interface{}{speed}})
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slhs.GetName(), id, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#%s, HTTP error code: '%d'", method, errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to %s to '%d' for hardware with id '%d', got '%s' as response from the API.", method, speed, id, res))
+	}
+
+	return true, nil
+}
+
+func (slhs *softLayer_Hardware_Service) createFirmwareTransaction(id int, method string, parameters []interface{}) (bool, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Hardware_InitParameters{Parameters: parameters})
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slhs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slhs.GetName(), id, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Hardware#%s, HTTP error code: '%d'", method, errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to %s for hardware with id '%d', got '%s' as response from the API.", method, id, res))
+	}
+
+	return true, nil
+}
+
+func (slhs *softLayer_Hardware_Service) firmwareFlag(enabled bool) int {
+	if enabled {
+		return 1
+	}
+
+	return 0
+}
+
+func (slhs *softLayer_Hardware_Service) checkBareMetalServerOrderRequiredValues(options *softlayer.BareMetalServerOrderOptions) error {
+	if options == nil {
+		return errors.New("Bare metal server order options are required")
+	}
+
+	var err error
+	errorMessage, errorTemplate := "", "* %s is required and cannot be empty\n"
+
+	if options.Hostname == "" {
+		errorMessage += fmt.Sprintf(errorTemplate, "Hostname for the bare metal server")
+	}
+
+	if options.Domain == "" {
+		errorMessage += fmt.Sprintf(errorTemplate, "Domain for the bare metal server")
+	}
+
+	if options.Datacenter == "" {
+		errorMessage += fmt.Sprintf(errorTemplate, "Datacenter: specifies which datacenter the server is to be provisioned in")
+	}
+
+	if options.PackageId <= 0 {
+		errorMessage += fmt.Sprintf(errorTemplate, "PackageId: the hardware server package to order from")
+	}
+
+	if options.PresetKeyName == "" && len(options.ItemKeyNames) == 0 {
+		errorMessage += fmt.Sprintf(errorTemplate, "PresetKeyName or ItemKeyNames: the configuration of the server")
+	}
+
+	if errorMessage != "" {
+		err = errors.New(errorMessage)
+	}
+
+	return err
+}
+
+func (slhs *softLayer_Hardware_Service) findItemPriceByKeyName(itemPrices []datatypes.SoftLayer_Product_Item_Price, keyName string) (datatypes.SoftLayer_Product_Item_Price, error) {
+	for _, itemPrice := range itemPrices {
+		if itemPrice.Item == nil || itemPrice.LocationGroupId != 0 {
+			continue
+		}
+
+		if itemPrice.Item.KeyName == keyName {
+			return itemPrice, nil
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find item price for '%s'", keyName))
+}
+
+func (slhs *softLayer_Hardware_Service) findHardwareByOrderId(orderId int) (datatypes.SoftLayer_Hardware, error) {
+	filter := fmt.Sprintf(`{"hardware":{"billingItem":{"orderItem":{"order":{"id":{"operation":%d}}}}}}`, orderId)
+
+	accountService, err := slhs.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	hardwares, err := accountService.GetHardwareWithFilter(filter)
+	if err != nil {
+		return datatypes.SoftLayer_Hardware{}, err
+	}
+
+	if len(hardwares) == 1 {
+		return hardwares[0], nil
+	}
+
+	return datatypes.SoftLayer_Hardware{}, errors.New(fmt.Sprintf("Cannot find hardware with order id %d", orderId))
+}
+
+func (slhs *softLayer_Hardware_Service) findBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error) {
+	billingItem, err := slhs.GetBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	if billingItem.Id == 0 {
+		return datatypes.SoftLayer_Billing_Item{}, errors.New(fmt.Sprintf("No billing item found for hardware with id '%d'", id))
+	}
+
+	return billingItem, nil
+}
@@ -635,4 +635,1033 @@ var _ = Describe("SoftLayer_Hardware", func() {
 			})
 		})
 	})
+
+	Context("#GetBillingItem", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getBillingItem.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the billing item of the hardware", func() {
+			billingItem, err := hardwareService.GetBillingItem(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(billingItem.Id).To(Equal(5678))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/getBillingItem.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetBillingItem(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetBillingItem(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#CancelObject", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Hardware_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_createObject.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("creates a cancellation request for the billing item of the hardware", func() {
+			request, err := hardwareService.CancelObject(1234567, &softlayer.CancellationOptions{Immediate: true, Notes: "fake-notes"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/createObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"notes":"fake-notes","items":[{"billingItemId":5678,"immediateCancellationFlag":true}]`))
+		})
+
+		It("fails when the hardware has no billing item", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("{}")
+
+			_, err := hardwareService.CancelObject(1234567, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+	})
+
+	Context("#GetPendingCancellationRequest", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Hardware_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("returns the pending cancellation request of the hardware", func() {
+			request, err := hardwareService.GetPendingCancellationRequest(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+			Expect(request.Status.KeyName).To(Equal("PENDING"))
+		})
+	})
+
+	Context("#ReverseCancellation", func() {
+		It("voids the pending cancellation request of the hardware", func() {
+			fileNames := []string{
+				"SoftLayer_Hardware_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+
+			reversed, err := hardwareService.ReverseCancellation(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reversed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/123/void.json"))
+		})
+
+		It("fails when no cancellation is pending", func() {
+			fileNames := []string{
+				"SoftLayer_Hardware_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests_none_pending.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			reversed, err := hardwareService.ReverseCancellation(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(reversed).To(BeFalse())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+	})
+
+	Context("#BuildBareMetalServerOrder", func() {
+		var options *softlayer.BareMetalServerOrderOptions
+
+		BeforeEach(func() {
+			options = &softlayer.BareMetalServerOrderOptions{
+				Hostname:      "bm-test",
+				Domain:        "softlayer.com",
+				Datacenter:    "dal10",
+				PackageId:     200,
+				PresetKeyName: "S1270_32GB_1X1TB_NONRAID",
+				ItemKeyNames: []string{
+					"OS_UBUNTU_14_04_LTS_TRUSTY_TAHR_64_BIT",
+					"10_MBPS_PUBLIC_PRIVATE_NETWORK_UPLINKS",
+					"BANDWIDTH_500_GB",
+				},
+				SshKeyIds:          []int{123},
+				ProvisionScriptUri: "https://example.com/provision.sh",
+			}
+		})
+
+		It("resolves the preset and the standard item prices into a hardware server order", func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getActivePresets.json",
+				"SoftLayer_Product_Package_getItemPrices_bare_metal.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			order, err := hardwareService.BuildBareMetalServerOrder(options)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(order.ComplexType).To(Equal("SoftLayer_Container_Product_Order_Hardware_Server"))
+			Expect(order.Location).To(Equal("dal10"))
+			Expect(order.PackageId).To(Equal(200))
+			Expect(order.PresetId).To(Equal(64))
+			Expect(order.Quantity).To(Equal(1))
+			Expect(order.Hardware).To(Equal([]datatypes.Hardware{datatypes.Hardware{Hostname: "bm-test", Domain: "softlayer.com"}}))
+			Expect(order.Prices).To(Equal([]datatypes.SoftLayer_Product_Item_Price{
+				datatypes.SoftLayer_Product_Item_Price{Id: 37650},
+				datatypes.SoftLayer_Product_Item_Price{Id: 272},
+				datatypes.SoftLayer_Product_Item_Price{Id: 50357},
+			}))
+			Expect(order.SshKeys[0].SshKeyIds).To(Equal([]int{123}))
+			Expect(order.ProvisionScripts).To(Equal([]string{"https://example.com/provision.sh"}))
+		})
+
+		It("does not look up presets when none is given", func() {
+			options.PresetKeyName = ""
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Product_Package_getItemPrices_bare_metal.json"})
+
+			order, err := hardwareService.BuildBareMetalServerOrder(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.PresetId).To(Equal(0))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails when the preset is not active in the package", func() {
+			options.PresetKeyName = "FAKE_PRESET"
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Product_Package_getActivePresets.json"})
+
+			_, err := hardwareService.BuildBareMetalServerOrder(options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("FAKE_PRESET"))
+		})
+
+		It("fails when an item has no standard price", func() {
+			options.ItemKeyNames = []string{"FAKE_ITEM"}
+			fileNames := []string{
+				"SoftLayer_Product_Package_getActivePresets.json",
+				"SoftLayer_Product_Package_getItemPrices_bare_metal.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			_, err := hardwareService.BuildBareMetalServerOrder(options)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("FAKE_ITEM"))
+		})
+
+		It("fails when required values are missing", func() {
+			_, err := hardwareService.BuildBareMetalServerOrder(&softlayer.BareMetalServerOrderOptions{})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("Hostname"))
+			Expect(err.Error()).To(ContainSubstring("PackageId"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+
+	Context("#VerifyBareMetalServerOrder", func() {
+		It("verifies the order without placing it", func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getActivePresets.json",
+				"SoftLayer_Product_Order_verifyOrder_hardware_server.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			order, err := hardwareService.VerifyBareMetalServerOrder(&softlayer.BareMetalServerOrderOptions{
+				Hostname:      "bm-test",
+				Domain:        "softlayer.com",
+				Datacenter:    "dal10",
+				PackageId:     200,
+				PresetKeyName: "S1270_32GB_1X1TB_NONRAID",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.PresetId).To(Equal(64))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/verifyOrder.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+	})
+
+	Context("#OrderBareMetalServer", func() {
+		var options *softlayer.BareMetalServerOrderOptions
+
+		BeforeEach(func() {
+			options = &softlayer.BareMetalServerOrderOptions{
+				Hostname:      "bm-test",
+				Domain:        "softlayer.com",
+				Datacenter:    "dal10",
+				PackageId:     200,
+				PresetKeyName: "S1270_32GB_1X1TB_NONRAID",
+			}
+
+			os.Setenv("SL_CREATE_BARE_METAL_SERVER_TIMEOUT", "3")
+			os.Setenv("SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL", "1")
+		})
+
+		AfterEach(func() {
+			os.Setenv("SL_CREATE_BARE_METAL_SERVER_TIMEOUT", "")
+			os.Setenv("SL_CREATE_BARE_METAL_SERVER_POLLING_INTERVAL", "")
+		})
+
+		It("verifies and places the order then returns the provisioned hardware", func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getActivePresets.json",
+				"SoftLayer_Product_Order_verifyOrder_hardware_server.json",
+				"SoftLayer_Product_Order_placeOrder_hardware_server.json",
+				"SoftLayer_Account_Service_getHardware_not_provisioned.json",
+				"SoftLayer_Account_Service_getHardware_provisioned.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			hardware, err := hardwareService.OrderBareMetalServer(options)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(hardware.Id).To(Equal(1122334))
+			Expect(hardware.ProvisionDate).ToNot(BeNil())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"hardware":{"billingItem":{"orderItem":{"order":{"id":{"operation":8765432}}}}}}`))
+		})
+
+		It("fails when the hardware is not provisioned in time", func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getActivePresets.json",
+				"SoftLayer_Product_Order_verifyOrder_hardware_server.json",
+				"SoftLayer_Product_Order_placeOrder_hardware_server.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			for i := 0; i < 5; i++ {
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Account_Service_getHardware_not_provisioned.json"})
+			}
+
+			_, err := hardwareService.OrderBareMetalServer(options)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("does not place the order when verification fails", func() {
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Product_Package_getActivePresets.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("fake-invalid-order"))
+
+			_, err := hardwareService.OrderBareMetalServer(options)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/verifyOrder.json"))
+		})
+	})
+
+	Context("#ReloadOperatingSystem", func() {
+		var template datatypes.Image_Template_Config
+
+		BeforeEach(func() {
+			template = datatypes.Image_Template_Config{
+				OperatingSystemReferenceCode: "UBUNTU_14_64",
+				SshKeyIds:                    []int{123},
+			}
+		})
+
+		It("forces the OS reload of the hardware", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+
+			err := hardwareService.ReloadOperatingSystem(1234567, template)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/reloadOperatingSystem.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["FORCE",{"operatingSystemReferenceCode":"UBUNTU_14_64","sshKeyIds":[123]}]}`))
+		})
+
+		It("fails to reload the OS of the hardware", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 500
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to reload the operating system.","code":"SoftLayer_Exception_Public"}`)
+
+			err := hardwareService.ReloadOperatingSystem(1234567, template)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+
+					err := hardwareService.ReloadOperatingSystem(1234567, template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+
+					err := hardwareService.ReloadOperatingSystem(1234567, template)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetReloadOperatingSystemToken", func() {
+		It("returns the confirmation token without reloading the OS", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"a1b2c3d4e5f6"`)
+
+			token, err := hardwareService.GetReloadOperatingSystemToken(1234567, datatypes.Image_Template_Config{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal("a1b2c3d4e5f6"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["",{}]}`))
+		})
+	})
+
+	Context("#ConfirmReloadOperatingSystem", func() {
+		It("reloads the OS using the confirmation token", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+
+			err := hardwareService.ConfirmReloadOperatingSystem(1234567, "a1b2c3d4e5f6", datatypes.Image_Template_Config{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["a1b2c3d4e5f6",{}]}`))
+		})
+
+		It("reloads the OS when the API returns true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			err := hardwareService.ConfirmReloadOperatingSystem(1234567, "a1b2c3d4e5f6", datatypes.Image_Template_Config{})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/reloadOperatingSystem.json"))
+		})
+
+		It("fails to reload the OS when the token is rejected", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 500
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Invalid token.","code":"SoftLayer_Exception_Public"}`)
+
+			err := hardwareService.ConfirmReloadOperatingSystem(1234567, "expired-token", datatypes.Image_Template_Config{})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#BootToRescueLayer", func() {
+		It("boots the hardware into the rescue layer", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			booted, err := hardwareService.BootToRescueLayer(1234567, "linux")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(booted).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/bootToRescueLayer.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["linux"]}`))
+		})
+
+		It("fails to boot the hardware into the rescue layer", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			booted, err := hardwareService.BootToRescueLayer(1234567, "linux")
+			Expect(err).To(HaveOccurred())
+			Expect(booted).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.BootToRescueLayer(1234567, "linux")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.BootToRescueLayer(1234567, "linux")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRemoteManagementAccounts", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getRemoteManagementAccounts.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the IPMI accounts of the hardware", func() {
+			accounts, err := hardwareService.GetRemoteManagementAccounts(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(accounts)).To(Equal(1))
+			Expect(accounts[0].Username).To(Equal("root"))
+			Expect(accounts[0].Password).To(Equal("fake-ipmi-password"))
+			Expect(accounts[0].RemoteManagementType).To(Equal("IPMI"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetRemoteManagementAccounts(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetRemoteManagementAccounts(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetNetworkManagementIpAddress", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"10.120.4.57"`)
+		})
+
+		It("returns the IPMI IP address of the hardware", func() {
+			ipAddress, err := hardwareService.GetNetworkManagementIpAddress(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipAddress).To(Equal("10.120.4.57"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/getNetworkManagementIpAddress.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetNetworkManagementIpAddress(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetNetworkManagementIpAddress(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#CreateFirmwareUpdateTransaction", func() {
+		It("updates the firmware of the selected components", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			updated, err := hardwareService.CreateFirmwareUpdateTransaction(1234567, &softlayer.FirmwareUpdateOptions{Ipmi: true, Bios: true, NetworkCard: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updated).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/createFirmwareUpdateTransaction.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[1,0,1,0,1]}`))
+		})
+
+		It("fails to update the firmware", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			updated, err := hardwareService.CreateFirmwareUpdateTransaction(1234567, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(updated).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.CreateFirmwareUpdateTransaction(1234567, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.CreateFirmwareUpdateTransaction(1234567, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#CreateFirmwareReflashTransaction", func() {
+		It("reflashes the firmware of the selected components", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			reflashed, err := hardwareService.CreateFirmwareReflashTransaction(1234567, &softlayer.FirmwareUpdateOptions{RaidController: true, HardDrive: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reflashed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/createFirmwareReflashTransaction.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[0,1,0]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.CreateFirmwareReflashTransaction(1234567, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := hardwareService.CreateFirmwareReflashTransaction(1234567, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetComponents", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getComponents.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the components of the hardware with their models", func() {
+			components, err := hardwareService.GetComponents(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(components)).To(Equal(2))
+			Expect(components[0].HardwareComponentModel.Manufacturer).To(Equal("Intel"))
+			Expect(components[0].HardwareComponentModel.HardwareGenericComponentModel.Capacity).To(Equal("2.4"))
+			Expect(components[0].HardwareComponentModel.HardwareGenericComponentModel.HardwareComponentType.KeyName).To(Equal("PROCESSOR"))
+			Expect(components[1].HardwareComponentModel.HardwareGenericComponentModel.HardwareComponentType.KeyName).To(Equal("RAM"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Hardware/1234567/getComponents.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetComponents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetComponents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetHardDrives", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getHardDrives.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the hard drives of the hardware", func() {
+			drives, err := hardwareService.GetHardDrives(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(drives)).To(Equal(1))
+			Expect(drives[0].SerialNumber).To(Equal("WD-WCAW36501234"))
+			Expect(drives[0].HardwareComponentModel.HardwareGenericComponentModel.Capacity).To(Equal("1000"))
+			Expect(drives[0].HardwareComponentModel.HardwareGenericComponentModel.Units).To(Equal("GB"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Hardware/1234567/getHardDrives.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetHardDrives(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetHardDrives(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRaidControllers", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getRaidControllers.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the RAID controllers of the hardware", func() {
+			controllers, err := hardwareService.GetRaidControllers(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(controllers)).To(Equal(1))
+			Expect(controllers[0].HardwareComponentModel.Name).To(Equal("9361-8i"))
+			Expect(controllers[0].HardwareComponentModel.Version).To(Equal("4.650.00-6121"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Hardware/1234567/getRaidControllers.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetRaidControllers(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetRaidControllers(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetNetworkComponents", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getNetworkComponents.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the network components of the hardware", func() {
+			networkComponents, err := hardwareService.GetNetworkComponents(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(networkComponents)).To(Equal(2))
+			Expect(networkComponents[0].MacAddress).To(Equal("0c:c4:7a:5e:12:34"))
+			Expect(networkComponents[0].PrimaryIpAddress).To(Equal("169.45.12.34"))
+			Expect(networkComponents[1].Port).To(Equal(0))
+			Expect(networkComponents[1].MaxSpeed).To(Equal(1000))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/getNetworkComponents.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetNetworkComponents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetNetworkComponents(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetSensorData", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Hardware_Service_getSensorData.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the sensor readings of the hardware", func() {
+			readings, err := hardwareService.GetSensorData(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(readings)).To(Equal(2))
+			Expect(readings[0].SensorId).To(Equal("CPU1 Temp"))
+			Expect(readings[0].SensorReading).To(Equal("42.000"))
+			Expect(readings[0].SensorUnits).To(Equal("degrees C"))
+			Expect(readings[0].UpperCritical).To(Equal("89.000"))
+			Expect(readings[1].LowerCritical).To(Equal("500.000"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/getSensorData.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetSensorData(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.GetSensorData(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ActivatePrivatePort", func() {
+		It("returns true when the API succeeds", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			done, err := hardwareService.ActivatePrivatePort(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/activatePrivatePort.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			done, err := hardwareService.ActivatePrivatePort(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ActivatePrivatePort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ActivatePrivatePort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ActivatePublicPort", func() {
+		It("returns true when the API succeeds", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			done, err := hardwareService.ActivatePublicPort(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/activatePublicPort.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			done, err := hardwareService.ActivatePublicPort(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ActivatePublicPort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ActivatePublicPort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ShutdownPrivatePort", func() {
+		It("returns true when the API succeeds", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			done, err := hardwareService.ShutdownPrivatePort(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/shutdownPrivatePort.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			done, err := hardwareService.ShutdownPrivatePort(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ShutdownPrivatePort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ShutdownPrivatePort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ShutdownPublicPort", func() {
+		It("returns true when the API succeeds", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			done, err := hardwareService.ShutdownPublicPort(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(done).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/shutdownPublicPort.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			done, err := hardwareService.ShutdownPublicPort(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(done).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ShutdownPublicPort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.ShutdownPublicPort(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#SetPrivateNetworkInterfaceSpeed", func() {
+		It("changes the port speed of the hardware", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			changed, err := hardwareService.SetPrivateNetworkInterfaceSpeed(1234567, 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/setPrivateNetworkInterfaceSpeed.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[100]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := hardwareService.SetPrivateNetworkInterfaceSpeed(1234567, 100)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.SetPrivateNetworkInterfaceSpeed(1234567, 100)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.SetPrivateNetworkInterfaceSpeed(1234567, 100)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#SetPublicNetworkInterfaceSpeed", func() {
+		It("changes the port speed of the hardware", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			changed, err := hardwareService.SetPublicNetworkInterfaceSpeed(1234567, 100)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(changed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Hardware/1234567/setPublicNetworkInterfaceSpeed.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[100]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := hardwareService.SetPublicNetworkInterfaceSpeed(1234567, 100)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.SetPublicNetworkInterfaceSpeed(1234567, 100)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := hardwareService.SetPublicNetworkInterfaceSpeed(1234567, 100)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
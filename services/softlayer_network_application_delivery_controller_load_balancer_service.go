@@ -0,0 +1,125 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service(client softlayer.Client) *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service {
+	return &softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service{
+		client: client,
+	}
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) GetName() string {
+	return "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service"
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service, error) {
+	response, errorCode, err := slnadcss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slnadcss.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, errors.New(errorMessage)
+	}
+
+	service := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}
+	err = json.Unmarshal(response, &service)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, err
+	}
+
+	return service, nil
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) DeleteObject(id int) (bool, error) {
+	response, errorCode, err := slnadcss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slnadcss.GetName(), id), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service#deleteObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete load balancer service with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) GetStatus(id int) ([]datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry, error) {
+	response, errorCode, err := slnadcss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getStatus.json", slnadcss.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service#getStatus, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry{}, errors.New(errorMessage)
+	}
+
+	statusEntries := []datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry{}
+	err = json.Unmarshal(response, &statusEntries)
+	if err != nil {
+		return []datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry{}, err
+	}
+
+	return statusEntries, nil
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) ToggleStatus(id int) (bool, error) {
+	response, errorCode, err := slnadcss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/toggleStatus.json", slnadcss.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service#toggleStatus, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to toggle status of load balancer service with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) Enable(id int) (bool, error) {
+	return slnadcss.setEnabled(id, 1)
+}
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) Disable(id int) (bool, error) {
+	return slnadcss.setEnabled(id, 0)
+}
+
+//Private methods
+
+func (slnadcss *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service) setEnabled(id int, enabled int) (bool, error) {
+	service, err := slnadcss.GetObject(id)
+	if err != nil {
+		return false, err
+	}
+
+	if service.Enabled != nil && *service.Enabled == enabled {
+		return true, nil
+	}
+
+	return slnadcss.ToggleStatus(id)
+}
@@ -0,0 +1,127 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service(client softlayer.Client) *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service {
+	return &softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service{
+		client: client,
+	}
+}
+
+func (slnadcsgs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service) GetName() string {
+	return "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group"
+}
+
+func (slnadcsgs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service) GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group, error) {
+	response, errorCode, err := slnadcsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slnadcsgs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{}, errors.New(errorMessage)
+	}
+
+	serviceGroup := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{}
+	err = json.Unmarshal(response, &serviceGroup)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{}, err
+	}
+
+	return serviceGroup, nil
+}
+
+func (slnadcsgs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service) GetServices(id int) ([]datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service, error) {
+	objectMask := []string{
+		"id",
+		"enabled",
+		"ipAddressId",
+		"notes",
+		"port",
+		"serviceGroupId",
+		"status",
+		"ipAddress",
+		"healthChecks.type",
+		"groupReferences",
+	}
+
+	response, errorCode, err := slnadcsgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getServices.json", slnadcsgs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group#getServices, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, errors.New(errorMessage)
+	}
+
+	services := []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}
+	err = json.Unmarshal(response, &services)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{}, err
+	}
+
+	return services, nil
+}
+
+func (slnadcsgs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service) GetGraphImage(id int, graphType string, metric string) ([]byte, error) {
+	parameters := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_InitParameters{
+		Parameters: []interface{}{graphType, metric},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	response, errorCode, err := slnadcsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getGraphImage.json", slnadcsgs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group#getGraphImage, HTTP error code: '%d'", errorCode)
+		return []byte{}, errors.New(errorMessage)
+	}
+
+	//The image comes back base64 encoded, which json decodes into the raw bytes
+	graphImage := []byte{}
+	err = json.Unmarshal(response, &graphImage)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	return graphImage, nil
+}
+
+func (slnadcsgs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service) KickAllConnections(id int) (bool, error) {
+	response, errorCode, err := slnadcsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/kickAllConnections.json", slnadcsgs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group#kickAllConnections, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to kick all connections of load balancer service group with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
@@ -0,0 +1,198 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		loadBalancerServiceGroupService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service
+		err                             error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		loadBalancerServiceGroupService, err = fakeClient.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loadBalancerServiceGroupService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := loadBalancerServiceGroupService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the service group", func() {
+			serviceGroup, err := loadBalancerServiceGroupService.GetObject(60)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(serviceGroup.Id).To(Equal(60))
+			Expect(serviceGroup.Timeout).To(Equal(30))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group/60/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetObject(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetObject(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetServices", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service_getServices.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the services of the service group", func() {
+			services, err := loadBalancerServiceGroupService.GetServices(60)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(services).To(HaveLen(2))
+			Expect(services[0].Status).To(Equal("UP"))
+			Expect(services[0].HealthChecks[0].HealthCheckTypeId).To(Equal(21))
+			Expect(services[1].Status).To(Equal("DOWN"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group/60/getServices.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetServices(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetServices(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetGraphImage", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"iVBORw0KGgo="`)
+		})
+
+		It("returns the decoded connection graph image of the service group", func() {
+			graphImage, err := loadBalancerServiceGroupService.GetGraphImage(60, "connections", "day")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(graphImage).To(Equal([]byte("\x89PNG\r\n\x1a\n")))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group/60/getGraphImage.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["connections","day"]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetGraphImage(60, "connections", "day")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.GetGraphImage(60, "connections", "day")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#KickAllConnections", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("kicks all the connections of the service group", func() {
+			kicked, err := loadBalancerServiceGroupService.KickAllConnections(60)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(kicked).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group/60/kickAllConnections.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := loadBalancerServiceGroupService.KickAllConnections(60)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.KickAllConnections(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceGroupService.KickAllConnections(60)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
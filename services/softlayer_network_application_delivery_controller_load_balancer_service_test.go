@@ -0,0 +1,286 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		loadBalancerServiceService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service
+		err                        error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		loadBalancerServiceService, err = fakeClient.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loadBalancerServiceService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := loadBalancerServiceService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the service", func() {
+			service, err := loadBalancerServiceService.GetObject(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(service.Id).To(Equal(71))
+			Expect(*service.Enabled).To(Equal(0))
+			Expect(service.IpAddressId).To(Equal(881))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service/71/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.GetObject(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.GetObject(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DeleteObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("removes the service from its service group", func() {
+			deleted, err := loadBalancerServiceService.DeleteObject(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service/71.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := loadBalancerServiceService.DeleteObject(71)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.DeleteObject(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.DeleteObject(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetStatus", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service_getStatus.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the connection statistics of the service", func() {
+			statusEntries, err := loadBalancerServiceService.GetStatus(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(statusEntries).To(HaveLen(3))
+			Expect(statusEntries[0].Label).To(Equal("Current Connections"))
+			Expect(statusEntries[0].Value).To(Equal("12"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service/71/getStatus.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.GetStatus(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.GetStatus(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ToggleStatus", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("toggles the status of the service", func() {
+			toggled, err := loadBalancerServiceService.ToggleStatus(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(toggled).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service/71/toggleStatus.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := loadBalancerServiceService.ToggleStatus(71)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.ToggleStatus(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerServiceService.ToggleStatus(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Enable", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service_getObject.json",
+			})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+		})
+
+		It("toggles a disabled service", func() {
+			enabled, err := loadBalancerServiceService.Enable(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service/71/toggleStatus.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerServiceService.Enable(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerServiceService.Enable(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Disable", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service_getObject.json",
+			})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+		})
+
+		It("leaves an already disabled service untouched", func() {
+			disabled, err := loadBalancerServiceService.Disable(71)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(disabled).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerServiceService.Disable(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerServiceService.Disable(71)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
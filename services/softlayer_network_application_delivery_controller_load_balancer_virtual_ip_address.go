@@ -0,0 +1,192 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service(client softlayer.Client) *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service {
+	return &softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service{
+		client: client,
+	}
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) GetName() string {
+	return "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress"
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error) {
+	objectMask := []string{
+		"id",
+		"name",
+		"connectionLimit",
+		"loadBalancingMethod",
+		"loadBalancingMethodFullName",
+		"modifyDate",
+		"notes",
+		"securityCertificateId",
+		"sslActiveFlag",
+		"sslEnabledFlag",
+		"virtualIpAddress",
+		"virtualServers.serviceGroups.services.ipAddress",
+		"virtualServers.serviceGroups.services.healthChecks.type",
+		"virtualServers.serviceGroups.services.groupReferences",
+	}
+
+	response, errorCode, err := slnadcvs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnadcvs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, errors.New(errorMessage)
+	}
+
+	virtualIpAddress := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}
+	err = json.Unmarshal(response, &virtualIpAddress)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{}, err
+	}
+
+	return virtualIpAddress, nil
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) GetVirtualServers(id int) ([]datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer, error) {
+	response, errorCode, err := slnadcvs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getVirtualServers.json", slnadcvs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress#getVirtualServers, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{}, errors.New(errorMessage)
+	}
+
+	virtualServers := []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{}
+	err = json.Unmarshal(response, &virtualServers)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{}, err
+	}
+
+	return virtualServers, nil
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) EditObject(id int, template datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnadcvs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slnadcvs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit load balancer virtual ip address with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) AddService(id int, virtualServerId int, serviceGroupId int, template datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service) (bool, error) {
+	if template.IpAddressId == 0 || template.Port == 0 {
+		return false, errors.New("softlayer-go: IpAddressId and Port are required to add a load balancer service")
+	}
+
+	if template.Enabled == nil {
+		enabled := 1
+		template.Enabled = &enabled
+	}
+
+	virtualIpAddress, err := slnadcvs.GetObject(id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, virtualServer := range virtualIpAddress.VirtualServers {
+		if virtualServer.Id != virtualServerId {
+			continue
+		}
+
+		for _, serviceGroup := range virtualServer.ServiceGroups {
+			if serviceGroup.Id == serviceGroupId {
+				return slnadcvs.editService(id, virtualServerId, serviceGroupId, template)
+			}
+		}
+
+		return false, errors.New(fmt.Sprintf("No service group with id '%d' found on virtual server with id '%d'", serviceGroupId, virtualServerId))
+	}
+
+	return false, errors.New(fmt.Sprintf("No virtual server with id '%d' found on load balancer virtual ip address with id '%d'", virtualServerId, id))
+}
+
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) SetHealthCheck(id int, serviceId int, healthCheckTypeId int) (bool, error) {
+	virtualIpAddress, err := slnadcvs.GetObject(id)
+	if err != nil {
+		return false, err
+	}
+
+	for _, virtualServer := range virtualIpAddress.VirtualServers {
+		for _, serviceGroup := range virtualServer.ServiceGroups {
+			for _, service := range serviceGroup.Services {
+				if service.Id != serviceId {
+					continue
+				}
+
+				//The existing health check is edited in place, otherwise the API adds a second one
+				healthCheck := datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check{HealthCheckTypeId: healthCheckTypeId}
+				if len(service.HealthChecks) > 0 {
+					healthCheck.Id = service.HealthChecks[0].Id
+				}
+
+				return slnadcvs.editService(id, virtualServer.Id, serviceGroup.Id, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{
+					Id:           serviceId,
+					HealthChecks: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check{healthCheck},
+				})
+			}
+		}
+	}
+
+	return false, errors.New(fmt.Sprintf("No service with id '%d' found on load balancer virtual ip address with id '%d'", serviceId, id))
+}
+
+//Private methods
+
+//Only the path down to the edited service is sent, so the rest of the virtual ip address is left untouched
+func (slnadcvs *softLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service) editService(id int, virtualServerId int, serviceGroupId int, service datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service) (bool, error) {
+	return slnadcvs.EditObject(id, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{
+		Id: id,
+		VirtualServers: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{
+			datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer{
+				Id: virtualServerId,
+				ServiceGroups: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{
+					datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group{
+						Id:       serviceGroupId,
+						Services: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{service},
+					},
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,299 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		loadBalancerVirtualIpAddressService softlayer.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service
+		err                                 error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		loadBalancerVirtualIpAddressService, err = fakeClient.GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(loadBalancerVirtualIpAddressService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := loadBalancerVirtualIpAddressService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the virtual ip address with its virtual servers, service groups and services", func() {
+			virtualIpAddress, err := loadBalancerVirtualIpAddressService.GetObject(4001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualIpAddress.Id).To(Equal(4001))
+			Expect(virtualIpAddress.VirtualIpAddress).To(Equal("169.45.2.20"))
+			Expect(virtualIpAddress.LoadBalancingMethod).To(Equal("lc"))
+			Expect(virtualIpAddress.VirtualServers).To(HaveLen(1))
+
+			services := virtualIpAddress.VirtualServers[0].ServiceGroups[0].Services
+			Expect(services).To(HaveLen(2))
+			Expect(services[0].IpAddress.IpAddress).To(Equal("10.0.0.10"))
+			Expect(services[0].HealthChecks[0].Type.KeyName).To(Equal("HTTP"))
+			Expect(services[0].GroupReferences[0].Weight).To(Equal(50))
+			Expect(*services[1].Enabled).To(Equal(0))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress/4001/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.GetObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.GetObject(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetVirtualServers", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service_getVirtualServers.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the virtual servers of the virtual ip address", func() {
+			virtualServers, err := loadBalancerVirtualIpAddressService.GetVirtualServers(4001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(virtualServers).To(HaveLen(1))
+			Expect(virtualServers[0].Port).To(Equal(80))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress/4001/getVirtualServers.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.GetVirtualServers(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.GetVirtualServers(4001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("edits the virtual ip address", func() {
+			edited, err := loadBalancerVirtualIpAddressService.EditObject(4001, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{Notes: "drained"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress/4001/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"notes":"drained"}]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := loadBalancerVirtualIpAddressService.EditObject(4001, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.EditObject(4001, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := loadBalancerVirtualIpAddressService.EditObject(4001, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AddService", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service_getObject.json",
+			})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+		})
+
+		It("adds the service to the service group of the virtual server", func() {
+			added, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{
+				IpAddressId: 882,
+				Port:        8080,
+				HealthChecks: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check{
+					datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Health_Check{HealthCheckTypeId: 21},
+				},
+				GroupReferences: []datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Reference{
+					datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Reference{Weight: 50},
+				},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(added).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress/4001/editObject.json"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"id":4001,"virtualServers":[{"id":50,"serviceGroups":[{"id":60,"services":[{"enabled":1,"ipAddressId":882,"port":8080,"healthChecks":[{"healthCheckTypeId":21}],"groupReferences":[{"weight":50}]}]}]}]}]}`))
+		})
+
+		It("keeps the service disabled when asked to", func() {
+			enabled := 0
+			_, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{Enabled: &enabled, IpAddressId: 882, Port: 8080})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"services":[{"enabled":0,"ipAddressId":882,"port":8080}]`))
+		})
+
+		It("fails when the virtual server does not exist", func() {
+			_, err := loadBalancerVirtualIpAddressService.AddService(4001, 51, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{IpAddressId: 882, Port: 8080})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the service group does not exist on the virtual server", func() {
+			_, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 61, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{IpAddressId: 882, Port: 8080})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails when the ip address or port is missing", func() {
+			_, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{IpAddressId: 882, Port: 8080})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerVirtualIpAddressService.AddService(4001, 50, 60, datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service{IpAddressId: 882, Port: 8080})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#SetHealthCheck", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service_getObject.json",
+			})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+		})
+
+		It("replaces the health checks of the service", func() {
+			set, err := loadBalancerVirtualIpAddressService.SetHealthCheck(4001, 71, 22)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(set).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"id":4001,"virtualServers":[{"id":50,"serviceGroups":[{"id":60,"services":[{"id":71,"healthChecks":[{"healthCheckTypeId":22}]}]}]}]}]}`))
+		})
+
+		It("edits the existing health check of the service", func() {
+			_, err := loadBalancerVirtualIpAddressService.SetHealthCheck(4001, 70, 22)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"id":4001,"virtualServers":[{"id":50,"serviceGroups":[{"id":60,"services":[{"id":70,"healthChecks":[{"id":90,"healthCheckTypeId":22}]}]}]}]}]}`))
+		})
+
+		It("fails when the service does not exist", func() {
+			_, err := loadBalancerVirtualIpAddressService.SetHealthCheck(4001, 72, 22)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerVirtualIpAddressService.SetHealthCheck(4001, 71, 22)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := loadBalancerVirtualIpAddressService.SetHealthCheck(4001, 71, 22)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
@@ -0,0 +1,131 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Component_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Component_Service(client softlayer.Client) *softLayer_Network_Component_Service {
+	return &softLayer_Network_Component_Service{
+		client: client,
+	}
+}
+
+func (slncs *softLayer_Network_Component_Service) GetName() string {
+	return "SoftLayer_Network_Component"
+}
+
+func (slncs *softLayer_Network_Component_Service) GetObject(id int) (datatypes.SoftLayer_Network_Component, error) {
+	response, errorCode, err := slncs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slncs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Component{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Component{}, errors.New(errorMessage)
+	}
+
+	networkComponent := datatypes.SoftLayer_Network_Component{}
+	err = json.Unmarshal(response, &networkComponent)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Component{}, err
+	}
+
+	return networkComponent, nil
+}
+
+func (slncs *softLayer_Network_Component_Service) GetNetworkVlanTrunks(id int) ([]datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk, error) {
+	objectMask := []string{
+		"id",
+		"networkComponentId",
+		"networkVlanId",
+		"networkVlan",
+	}
+
+	response, errorCode, err := slncs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getNetworkVlanTrunks.json", slncs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component#getNetworkVlanTrunks, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk{}, errors.New(errorMessage)
+	}
+
+	vlanTrunks := []datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk{}
+	err = json.Unmarshal(response, &vlanTrunks)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk{}, err
+	}
+
+	return vlanTrunks, nil
+}
+
+func (slncs *softLayer_Network_Component_Service) AddNetworkVlanTrunks(id int, vlanIds []int) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	return slncs.updateNetworkVlanTrunks(id, "addNetworkVlanTrunks", vlanIds)
+}
+
+func (slncs *softLayer_Network_Component_Service) RemoveNetworkVlanTrunks(id int, vlanIds []int) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	return slncs.updateNetworkVlanTrunks(id, "removeNetworkVlanTrunks", vlanIds)
+}
+
+func (slncs *softLayer_Network_Component_Service) ClearNetworkVlanTrunks(id int) (bool, error) {
+	response, errorCode, err := slncs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/clearNetworkVlanTrunks.json", slncs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component#clearNetworkVlanTrunks, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to clear VLAN trunks of network component with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+//Private methods
+
+func (slncs *softLayer_Network_Component_Service) updateNetworkVlanTrunks(id int, method string, vlanIds []int) ([]datatypes.SoftLayer_Network_Vlan, error) {
+	networkVlans := []datatypes.NetworkVlan{}
+	for _, vlanId := range vlanIds {
+		networkVlans = append(networkVlans, datatypes.NetworkVlan{Id: vlanId})
+	}
+
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Network_Component_InitParameters{Parameters: []interface{}{networkVlans}})
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	response, errorCode, err := slncs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slncs.GetName(), id, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component#%s, HTTP error code: '%d'", method, errorCode)
+		return []datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	trunkedVlans := []datatypes.SoftLayer_Network_Vlan{}
+	err = json.Unmarshal(response, &trunkedVlans)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	return trunkedVlans, nil
+}
@@ -0,0 +1,106 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Component_Firewall_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Component_Firewall_Service(client softlayer.Client) *softLayer_Network_Component_Firewall_Service {
+	return &softLayer_Network_Component_Firewall_Service{
+		client: client,
+	}
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) GetName() string {
+	return "SoftLayer_Network_Component_Firewall"
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) GetObject(id int) (datatypes.SoftLayer_Network_Component_Firewall, error) {
+	objectMask := []string{
+		"id",
+		"status",
+		"networkComponentId",
+		"guestNetworkComponentId",
+		"rules",
+	}
+
+	response, errorCode, err := slncfs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slncfs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Component_Firewall{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component_Firewall#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Component_Firewall{}, errors.New(errorMessage)
+	}
+
+	firewall := datatypes.SoftLayer_Network_Component_Firewall{}
+	err = json.Unmarshal(response, &firewall)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Component_Firewall{}, err
+	}
+
+	return firewall, nil
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) GetRules(id int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error) {
+	response, errorCode, err := slncfs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getRules.json", slncfs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Component_Firewall#getRules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, errors.New(errorMessage)
+	}
+
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	err = json.Unmarshal(response, &rules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	return rules, nil
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) UpdateRules(id int, rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	return slncfs.createUpdateRequest(id, false, rules)
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) Bypass(id int) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	return slncfs.createUpdateRequest(id, true, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{})
+}
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) Unbypass(id int) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	rules, err := slncfs.GetRules(id)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return slncfs.createUpdateRequest(id, false, rules)
+}
+
+//Private methods
+
+func (slncfs *softLayer_Network_Component_Firewall_Service) createUpdateRequest(id int, bypass bool, rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	updateRequestService, err := slncfs.client.GetSoftLayer_Network_Firewall_Update_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return updateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+		NetworkComponentFirewallId: id,
+		BypassFlag:                 bypass,
+		Rules:                      rules,
+	})
+}
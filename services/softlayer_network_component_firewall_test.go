@@ -0,0 +1,259 @@
+package services_test
+
+import (
+	"encoding/json"
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Component_Firewall", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkComponentFirewallService softlayer.SoftLayer_Network_Component_Firewall_Service
+		err                             error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkComponentFirewallService, err = fakeClient.GetSoftLayer_Network_Component_Firewall_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkComponentFirewallService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkComponentFirewallService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Component_Firewall"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Firewall_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the firewall with its status and rules", func() {
+			firewall, err := networkComponentFirewallService.GetObject(6001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firewall.Id).To(Equal(6001))
+			Expect(firewall.Status).To(Equal("allow_edit"))
+			Expect(firewall.NetworkComponentId).To(Equal(3001))
+			Expect(firewall.Rules).To(HaveLen(1))
+			Expect(firewall.Rules[0].DestinationPortRangeStart).To(Equal(22))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Component_Firewall/6001/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentFirewallService.GetObject(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentFirewallService.GetObject(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Firewall_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the rules of the firewall", func() {
+			rules, err := networkComponentFirewallService.GetRules(6001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].Action).To(Equal("permit"))
+			Expect(rules[1].Action).To(Equal("deny"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Component_Firewall/6001/getRules.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentFirewallService.GetRules(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentFirewallService.GetRules(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#UpdateRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("submits the rules through an update request", func() {
+			rules, err := testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Firewall_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			updateRequest, err := networkComponentFirewallService.UpdateRules(6001, unmarshalFirewallRules(rules))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequest.Id).To(Equal(5501))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"bypassFlag":false,"networkComponentFirewallId":6001`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"notes":"default deny"`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.UpdateRules(6001, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.UpdateRules(6001, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Bypass", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("submits a bypass update request without rules", func() {
+			_, err := networkComponentFirewallService.Bypass(6001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[{"bypassFlag":true,"networkComponentFirewallId":6001,"rules":[]}]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.Bypass(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.Bypass(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Unbypass", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Component_Firewall_Service_getRules.json",
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("resubmits the current rules with the bypass turned off", func() {
+			_, err := networkComponentFirewallService.Unbypass(6001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"bypassFlag":false,"networkComponentFirewallId":6001`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"action":"deny"`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.Unbypass(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkComponentFirewallService.Unbypass(6001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
+
+func unmarshalFirewallRules(data []byte) []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule {
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	err := json.Unmarshal(data, &rules)
+	Expect(err).ToNot(HaveOccurred())
+
+	return rules
+}
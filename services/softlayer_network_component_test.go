@@ -0,0 +1,242 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Component", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkComponentService softlayer.SoftLayer_Network_Component_Service
+		err                     error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkComponentService, err = fakeClient.GetSoftLayer_Network_Component_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkComponentService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkComponentService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Component"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the network component", func() {
+			networkComponent, err := networkComponentService.GetObject(3001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(networkComponent.Id).To(Equal(3001))
+			Expect(networkComponent.HardwareId).To(Equal(1234567))
+			Expect(networkComponent.MacAddress).To(Equal("0c:c4:7a:5e:12:34"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Component/3001/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.GetObject(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.GetObject(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetNetworkVlanTrunks", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Service_getNetworkVlanTrunks.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the VLAN trunks of the network component", func() {
+			vlanTrunks, err := networkComponentService.GetNetworkVlanTrunks(3001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(vlanTrunks)).To(Equal(1))
+			Expect(vlanTrunks[0].Id).To(Equal(55001))
+			Expect(vlanTrunks[0].NetworkComponentId).To(Equal(3001))
+			Expect(vlanTrunks[0].NetworkVlanId).To(Equal(1122))
+			Expect(vlanTrunks[0].NetworkVlan.VlanNumber).To(Equal(1420))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Component/3001/getNetworkVlanTrunks.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("networkVlan"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.GetNetworkVlanTrunks(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.GetNetworkVlanTrunks(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AddNetworkVlanTrunks", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Service_addNetworkVlanTrunks.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("trunks the VLANs on the network component", func() {
+			vlans, err := networkComponentService.AddNetworkVlanTrunks(3001, []int{1122, 3344})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(vlans)).To(Equal(2))
+			Expect(vlans[1].Id).To(Equal(3344))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Component/3001/addNetworkVlanTrunks.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[[{"id":1122},{"id":3344}]]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.AddNetworkVlanTrunks(3001, []int{1122})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.AddNetworkVlanTrunks(3001, []int{1122})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#RemoveNetworkVlanTrunks", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Component_Service_getNetworkVlanTrunks.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the VLANs trunked on the network component", func() {
+			vlans, err := networkComponentService.RemoveNetworkVlanTrunks(3001, []int{1122})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(vlans)).To(Equal(1))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Component/3001/removeNetworkVlanTrunks.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[[{"id":1122}]]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.RemoveNetworkVlanTrunks(3001, []int{1122})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.RemoveNetworkVlanTrunks(3001, []int{1122})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ClearNetworkVlanTrunks", func() {
+		It("removes all VLAN trunks from the network component", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			cleared, err := networkComponentService.ClearNetworkVlanTrunks(3001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cleared).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Component/3001/clearNetworkVlanTrunks.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			cleared, err := networkComponentService.ClearNetworkVlanTrunks(3001)
+			Expect(err).To(HaveOccurred())
+			Expect(cleared).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.ClearNetworkVlanTrunks(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkComponentService.ClearNetworkVlanTrunks(3001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
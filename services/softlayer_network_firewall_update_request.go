@@ -0,0 +1,253 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"time"
+
+	boshlog "github.com/cloudfoundry/bosh-utils/logger"
+	boshretry "github.com/cloudfoundry/bosh-utils/retrystrategy"
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	"github.com/pivotal-golang/clock"
+)
+
+const (
+	FIREWALL_UPDATE_REQUEST_STATUS_COMPLETE = "COMPLETE"
+	FIREWALL_UPDATE_REQUEST_STATUS_FAILED   = "FAILED"
+)
+
+type softLayer_Network_Firewall_Update_Request_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Firewall_Update_Request_Service(client softlayer.Client) *softLayer_Network_Firewall_Update_Request_Service {
+	return &softLayer_Network_Firewall_Update_Request_Service{
+		client: client,
+	}
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) GetName() string {
+	return "SoftLayer_Network_Firewall_Update_Request"
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) CreateObject(template datatypes.SoftLayer_Network_Firewall_Update_Request) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	err := slnfurs.checkCreateObjectRequiredValues(template)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	//The whole rule set is replaced by an update request, so rules are renumbered in the order given
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	for i, rule := range template.Rules {
+		rule.Id = 0
+		rule.OrderValue = i + 1
+		rules = append(rules, rule)
+	}
+	template.Rules = rules
+
+	parameters := datatypes.SoftLayer_Network_Firewall_Update_Request_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Firewall_Update_Request{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	response, errorCode, err := slnfurs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", slnfurs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Firewall_Update_Request#createObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, errors.New(errorMessage)
+	}
+
+	err = slnfurs.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	updateRequest := datatypes.SoftLayer_Network_Firewall_Update_Request{}
+	err = json.Unmarshal(response, &updateRequest)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return updateRequest, nil
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) GetObject(id int) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	response, errorCode, err := slnfurs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getObject.json", slnfurs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Firewall_Update_Request#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, errors.New(errorMessage)
+	}
+
+	updateRequest := datatypes.SoftLayer_Network_Firewall_Update_Request{}
+	err = json.Unmarshal(response, &updateRequest)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return updateRequest, nil
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) GetRules(id int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error) {
+	response, errorCode, err := slnfurs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getRules.json", slnfurs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Firewall_Update_Request#getRules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, errors.New(errorMessage)
+	}
+
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	err = json.Unmarshal(response, &rules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	return rules, nil
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) WaitForUpdateRequestToComplete(id int) error {
+	SL_FIREWALL_UPDATE_REQUEST_TIMEOUT, err := strconv.Atoi(os.Getenv("SL_FIREWALL_UPDATE_REQUEST_TIMEOUT"))
+	if err != nil || SL_FIREWALL_UPDATE_REQUEST_TIMEOUT == 0 {
+		SL_FIREWALL_UPDATE_REQUEST_TIMEOUT = 600
+	}
+	SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL, err := strconv.Atoi(os.Getenv("SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL"))
+	if err != nil || SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL == 0 {
+		SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL = 10
+	}
+
+	execStmtRetryable := boshretry.NewRetryable(
+		func() (bool, error) {
+			updateRequest, err := slnfurs.GetObject(id)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get firewall update request with id `%d` due to `%s`, retrying...", id, err.Error()))
+			}
+
+			switch updateRequest.Status {
+			case FIREWALL_UPDATE_REQUEST_STATUS_COMPLETE:
+				return false, nil
+			case FIREWALL_UPDATE_REQUEST_STATUS_FAILED:
+				return false, errors.New(fmt.Sprintf("Firewall update request with id `%d` failed to be applied", id))
+			}
+
+			return true, errors.New(fmt.Sprintf("Firewall update request with id `%d` has status `%s`, retrying...", id, updateRequest.Status))
+		})
+	timeService := clock.NewClock()
+	timeoutRetryStrategy := boshretry.NewTimeoutRetryStrategy(time.Duration(SL_FIREWALL_UPDATE_REQUEST_TIMEOUT)*time.Second, time.Duration(SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL)*time.Second, execStmtRetryable, timeService, boshlog.NewLogger(boshlog.LevelInfo))
+	err = timeoutRetryStrategy.Try()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to wait for firewall update request with id `%d` to complete within `%d` seconds: %s", id, SL_FIREWALL_UPDATE_REQUEST_TIMEOUT, err.Error()))
+	}
+
+	return nil
+}
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) DiffRules(current []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, desired []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) softlayer.FirewallRuleDiff {
+	diff := softlayer.FirewallRuleDiff{
+		Added:   []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{},
+		Removed: []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{},
+	}
+
+	//CreateObject renumbers the desired rules in the order given, so rules are compared by position
+	currentPositions := firewallRulePositions(current)
+
+	remaining := map[string]int{}
+	for i, rule := range current {
+		remaining[firewallRuleKey(currentPositions[i], rule)]++
+	}
+
+	for i, rule := range desired {
+		key := firewallRuleKey(i+1, rule)
+		if remaining[key] > 0 {
+			remaining[key]--
+			continue
+		}
+
+		diff.Added = append(diff.Added, rule)
+	}
+
+	for i, rule := range current {
+		key := firewallRuleKey(currentPositions[i], rule)
+		if remaining[key] > 0 {
+			remaining[key]--
+			diff.Removed = append(diff.Removed, rule)
+		}
+	}
+
+	return diff
+}
+
+//Private methods
+
+func (slnfurs *softLayer_Network_Firewall_Update_Request_Service) checkCreateObjectRequiredValues(template datatypes.SoftLayer_Network_Firewall_Update_Request) error {
+	var err error
+	errorMessage := ""
+
+	if template.NetworkComponentFirewallId == 0 && template.FirewallContextAccessControlListId == 0 {
+		errorMessage += "* NetworkComponentFirewallId or FirewallContextAccessControlListId is required and cannot be empty\n"
+	}
+
+	if template.NetworkComponentFirewallId != 0 && template.FirewallContextAccessControlListId != 0 {
+		errorMessage += "* NetworkComponentFirewallId and FirewallContextAccessControlListId cannot both be set\n"
+	}
+
+	for i, rule := range template.Rules {
+		if rule.Action != "permit" && rule.Action != "deny" {
+			errorMessage += fmt.Sprintf("* rule %d: Action must be 'permit' or 'deny', got '%s'\n", i, rule.Action)
+		}
+
+		if rule.Protocol == "" {
+			errorMessage += fmt.Sprintf("* rule %d: Protocol is required and cannot be empty\n", i)
+		}
+
+		if rule.SourceIpAddress == "" || rule.DestinationIpAddress == "" {
+			errorMessage += fmt.Sprintf("* rule %d: SourceIpAddress and DestinationIpAddress are required and cannot be empty\n", i)
+		}
+
+		if rule.DestinationPortRangeStart > rule.DestinationPortRangeEnd {
+			errorMessage += fmt.Sprintf("* rule %d: DestinationPortRangeStart '%d' cannot be greater than DestinationPortRangeEnd '%d'\n", i, rule.DestinationPortRangeStart, rule.DestinationPortRangeEnd)
+		}
+	}
+
+	if errorMessage != "" {
+		err = errors.New(errorMessage)
+	}
+
+	return err
+}
+
+func firewallRulePositions(rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) []int {
+	positions := make([]int, len(rules))
+	for i, rule := range rules {
+		positions[i] = 1
+		for j, other := range rules {
+			if other.OrderValue < rule.OrderValue || (other.OrderValue == rule.OrderValue && j < i) {
+				positions[i]++
+			}
+		}
+	}
+
+	return positions
+}
+
+func firewallRuleKey(position int, rule datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) string {
+	return fmt.Sprintf("%d|%s|%s|%s/%d|%s/%d|%d-%d|%d", position, rule.Action, rule.Protocol, rule.SourceIpAddress, rule.SourceIpCidr, rule.DestinationIpAddress, rule.DestinationIpCidr, rule.DestinationPortRangeStart, rule.DestinationPortRangeEnd, rule.Version)
+}
@@ -0,0 +1,309 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Firewall_Update_Request", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkFirewallUpdateRequestService softlayer.SoftLayer_Network_Firewall_Update_Request_Service
+		err                                 error
+
+		sshRule, denyRule datatypes.SoftLayer_Network_Firewall_Update_Request_Rule
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkFirewallUpdateRequestService, err = fakeClient.GetSoftLayer_Network_Firewall_Update_Request_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkFirewallUpdateRequestService).ToNot(BeNil())
+
+		sshRule = datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{
+			Action:                    "permit",
+			Protocol:                  "tcp",
+			SourceIpAddress:           "any",
+			DestinationIpAddress:      "10.0.0.5",
+			DestinationIpCidr:         32,
+			DestinationPortRangeStart: 22,
+			DestinationPortRangeEnd:   22,
+			Version:                   4,
+		}
+
+		denyRule = datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{
+			Action:               "deny",
+			Protocol:             "all",
+			SourceIpAddress:      "any",
+			DestinationIpAddress: "any",
+			Version:              4,
+		}
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkFirewallUpdateRequestService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Firewall_Update_Request"))
+		})
+	})
+
+	Context("#CreateObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Firewall_Update_Request_Service_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("submits the whole rule set renumbered in order", func() {
+			sshRule.Id = 1
+			sshRule.OrderValue = 7
+			updateRequest, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+				NetworkComponentFirewallId: 6001,
+				Rules:                      []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule, denyRule},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequest.Id).To(Equal(5501))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request.json"))
+
+			requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+			Expect(requestBody).To(ContainSubstring(`"bypassFlag":false,"networkComponentFirewallId":6001`))
+			Expect(requestBody).To(ContainSubstring(`{"orderValue":1,"action":"permit","protocol":"tcp"`))
+			Expect(requestBody).To(ContainSubstring(`{"orderValue":2,"action":"deny","protocol":"all"`))
+			Expect(requestBody).ToNot(ContainSubstring(`"id":1`))
+		})
+
+		It("sends an empty rule set when bypassing", func() {
+			_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+				FirewallContextAccessControlListId: 803,
+				BypassFlag:                         true,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[{"bypassFlag":true,"firewallContextAccessControlListId":803,"rules":[]}]}`))
+		})
+
+		It("fails when no firewall is targeted", func() {
+			_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when both firewall ids are set", func() {
+			_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+				NetworkComponentFirewallId:         6001,
+				FirewallContextAccessControlListId: 803,
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when a rule is invalid", func() {
+			sshRule.Action = "allow"
+			_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+				NetworkComponentFirewallId: 6001,
+				Rules:                      []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule},
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{NetworkComponentFirewallId: 6001})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{NetworkComponentFirewallId: 6001})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Firewall_Update_Request_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the update request", func() {
+			updateRequest, err := networkFirewallUpdateRequestService.GetObject(5501)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequest.Id).To(Equal(5501))
+			Expect(updateRequest.BypassFlag).To(BeTrue())
+			Expect(updateRequest.FirewallContextAccessControlListId).To(Equal(803))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request/5501/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.GetObject(5501)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.GetObject(5501)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Firewall_Update_Request_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the rules of the update request", func() {
+			rules, err := networkFirewallUpdateRequestService.GetRules(5501)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[1].Notes).To(Equal("default deny"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request/5501/getRules.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.GetRules(5501)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkFirewallUpdateRequestService.GetRules(5501)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#WaitForUpdateRequestToComplete", func() {
+		BeforeEach(func() {
+			os.Setenv("SL_FIREWALL_UPDATE_REQUEST_TIMEOUT", "3")
+			os.Setenv("SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL", "1")
+		})
+
+		AfterEach(func() {
+			os.Setenv("SL_FIREWALL_UPDATE_REQUEST_TIMEOUT", "")
+			os.Setenv("SL_FIREWALL_UPDATE_REQUEST_POLLING_INTERVAL", "")
+		})
+
+		It("waits until the update request is complete", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte(`{"id":5501,"status":"PENDING"}`),
+				[]byte(`{"id":5501,"status":"COMPLETE"}`),
+			}
+
+			err := networkFirewallUpdateRequestService.WaitForUpdateRequestToComplete(5501)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request/5501/getObject.json"))
+		})
+
+		It("fails when the update request failed", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"id":5501,"status":"FAILED"}`)
+
+			err := networkFirewallUpdateRequestService.WaitForUpdateRequestToComplete(5501)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the update request does not complete in time", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"id":5501,"status":"PENDING"}`)
+
+			err := networkFirewallUpdateRequestService.WaitForUpdateRequestToComplete(5501)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#DiffRules", func() {
+		It("returns the added and removed rules", func() {
+			httpRule := sshRule
+			httpRule.DestinationPortRangeStart = 80
+			httpRule.DestinationPortRangeEnd = 80
+
+			diff := networkFirewallUpdateRequestService.DiffRules([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule, denyRule}, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{httpRule, denyRule})
+			Expect(diff.Added).To(Equal([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{httpRule}))
+			Expect(diff.Removed).To(Equal([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule}))
+		})
+
+		It("ignores ids and notes", func() {
+			current := sshRule
+			current.Id = 1
+			current.Notes = "ssh"
+
+			diff := networkFirewallUpdateRequestService.DiffRules([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{current}, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule})
+			Expect(diff.Added).To(BeEmpty())
+			Expect(diff.Removed).To(BeEmpty())
+		})
+
+		It("compares the desired rules by position as CreateObject renumbers them", func() {
+			currentSshRule := sshRule
+			currentSshRule.OrderValue = 10
+			currentDenyRule := denyRule
+			currentDenyRule.OrderValue = 20
+
+			diff := networkFirewallUpdateRequestService.DiffRules([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{currentDenyRule, currentSshRule}, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{sshRule, denyRule})
+			Expect(diff.Added).To(BeEmpty())
+			Expect(diff.Removed).To(BeEmpty())
+		})
+
+		It("treats reordered rules as changed", func() {
+			currentSshRule := sshRule
+			currentSshRule.OrderValue = 1
+			currentDenyRule := denyRule
+			currentDenyRule.OrderValue = 2
+
+			diff := networkFirewallUpdateRequestService.DiffRules([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{currentSshRule, currentDenyRule}, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{denyRule, sshRule})
+			Expect(diff.Added).To(Equal([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{denyRule, sshRule}))
+			Expect(diff.Removed).To(Equal([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{currentSshRule, currentDenyRule}))
+		})
+
+		It("keeps track of duplicated rules", func() {
+			diff := networkFirewallUpdateRequestService.DiffRules([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{denyRule, denyRule}, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{denyRule})
+			Expect(diff.Added).To(BeEmpty())
+			Expect(diff.Removed).To(Equal([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{denyRule}))
+		})
+	})
+})
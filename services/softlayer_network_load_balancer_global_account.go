@@ -0,0 +1,112 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_LoadBalancer_Global_Account_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_LoadBalancer_Global_Account_Service(client softlayer.Client) *softLayer_Network_LoadBalancer_Global_Account_Service {
+	return &softLayer_Network_LoadBalancer_Global_Account_Service{
+		client: client,
+	}
+}
+
+func (slnlgas *softLayer_Network_LoadBalancer_Global_Account_Service) GetName() string {
+	return "SoftLayer_Network_LoadBalancer_Global_Account"
+}
+
+func (slnlgas *softLayer_Network_LoadBalancer_Global_Account_Service) GetObject(id int) (datatypes.SoftLayer_Network_LoadBalancer_Global_Account, error) {
+	objectMask := []string{
+		"id",
+		"hostname",
+		"averageConnectionsPerSecond",
+		"connectionsPerSecond",
+		"fallbackIp",
+		"loadBalanceTypeId",
+		"notes",
+		"hosts",
+	}
+
+	response, errorCode, err := slnlgas.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnlgas.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_LoadBalancer_Global_Account{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_LoadBalancer_Global_Account#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_LoadBalancer_Global_Account{}, errors.New(errorMessage)
+	}
+
+	globalAccount := datatypes.SoftLayer_Network_LoadBalancer_Global_Account{}
+	err = json.Unmarshal(response, &globalAccount)
+	if err != nil {
+		return datatypes.SoftLayer_Network_LoadBalancer_Global_Account{}, err
+	}
+
+	return globalAccount, nil
+}
+
+func (slnlgas *softLayer_Network_LoadBalancer_Global_Account_Service) EditObject(id int, template datatypes.SoftLayer_Network_LoadBalancer_Global_Account) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_LoadBalancer_Global_Account_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_LoadBalancer_Global_Account{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnlgas.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slnlgas.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_LoadBalancer_Global_Account#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit global load balancer account with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnlgas *softLayer_Network_LoadBalancer_Global_Account_Service) AddHost(id int, host datatypes.SoftLayer_Network_LoadBalancer_Global_Host) (bool, error) {
+	if host.DestinationIp == "" || host.DestinationPort == 0 {
+		return false, errors.New("softlayer-go: DestinationIp and DestinationPort are required to add a global load balancer host")
+	}
+
+	return slnlgas.EditObject(id, datatypes.SoftLayer_Network_LoadBalancer_Global_Account{
+		Hosts: []datatypes.SoftLayer_Network_LoadBalancer_Global_Host{host},
+	})
+}
+
+func (slnlgas *softLayer_Network_LoadBalancer_Global_Account_Service) RemoveHost(hostId int) (bool, error) {
+	response, errorCode, err := slnlgas.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("SoftLayer_Network_LoadBalancer_Global_Host/%d.json", hostId), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_LoadBalancer_Global_Host#deleteObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete global load balancer host with id '%d', got '%s' as response from the API.", hostId, res))
+	}
+
+	return true, nil
+}
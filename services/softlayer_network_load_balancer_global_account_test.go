@@ -0,0 +1,215 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_LoadBalancer_Global_Account", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		globalLoadBalancerAccountService softlayer.SoftLayer_Network_LoadBalancer_Global_Account_Service
+		err                              error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		globalLoadBalancerAccountService, err = fakeClient.GetSoftLayer_Network_LoadBalancer_Global_Account_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(globalLoadBalancerAccountService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := globalLoadBalancerAccountService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_LoadBalancer_Global_Account"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_LoadBalancer_Global_Account_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the global load balancer account with its hosts", func() {
+			globalAccount, err := globalLoadBalancerAccountService.GetObject(3301)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(globalAccount.Hostname).To(Equal("www.example.com"))
+			Expect(globalAccount.ConnectionsPerSecond).To(Equal(3))
+			Expect(globalAccount.Hosts).To(HaveLen(1))
+			Expect(globalAccount.Hosts[0].DestinationIp).To(Equal("169.45.2.20"))
+			Expect(globalAccount.Hosts[0].Status).To(Equal("UP"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_LoadBalancer_Global_Account/3301/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.GetObject(3301)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.GetObject(3301)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("edits the global load balancer account", func() {
+			edited, err := globalLoadBalancerAccountService.EditObject(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Account{FallbackIp: "169.45.2.98"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_LoadBalancer_Global_Account/3301/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"fallbackIp":"169.45.2.98"}]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := globalLoadBalancerAccountService.EditObject(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Account{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.EditObject(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Account{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.EditObject(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Account{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AddHost", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("adds the host to the global load balancer account", func() {
+			added, err := globalLoadBalancerAccountService.AddHost(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Host{
+				DestinationIp:   "169.45.3.20",
+				DestinationPort: 80,
+				Enabled:         1,
+				HealthCheck:     "http",
+				Weight:          1,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(added).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"hosts":[{"destinationIp":"169.45.3.20","destinationPort":80,"enabled":1,"healthCheck":"http","weight":1}]}]}`))
+		})
+
+		It("fails when the destination is missing", func() {
+			_, err := globalLoadBalancerAccountService.AddHost(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Host{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.AddHost(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Host{DestinationIp: "169.45.3.20", DestinationPort: 80})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.AddHost(3301, datatypes.SoftLayer_Network_LoadBalancer_Global_Host{DestinationIp: "169.45.3.20", DestinationPort: 80})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#RemoveHost", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("deletes the host", func() {
+			removed, err := globalLoadBalancerAccountService.RemoveHost(3401)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(removed).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_LoadBalancer_Global_Host/3401.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := globalLoadBalancerAccountService.RemoveHost(3401)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.RemoveHost(3401)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalLoadBalancerAccountService.RemoveHost(3401)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
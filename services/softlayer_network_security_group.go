@@ -0,0 +1,317 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_SecurityGroup_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_SecurityGroup_Service(client softlayer.Client) *softLayer_Network_SecurityGroup_Service {
+	return &softLayer_Network_SecurityGroup_Service{
+		client: client,
+	}
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) GetName() string {
+	return "SoftLayer_Network_SecurityGroup"
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) CreateObject(template datatypes.SoftLayer_Network_SecurityGroup) (datatypes.SoftLayer_Network_SecurityGroup, error) {
+	if template.Name == "" {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, errors.New("softlayer-go: name is required to create a security group")
+	}
+
+	err := slnsgs.checkRules(template.Rules, false)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	parameters := datatypes.SoftLayer_Network_SecurityGroup_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_SecurityGroup{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s.json", slnsgs.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#createObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_SecurityGroup{}, errors.New(errorMessage)
+	}
+
+	err = slnsgs.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	securityGroup := datatypes.SoftLayer_Network_SecurityGroup{}
+	err = json.Unmarshal(response, &securityGroup)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	return securityGroup, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) GetObject(id int) (datatypes.SoftLayer_Network_SecurityGroup, error) {
+	objectMask := []string{
+		"id",
+		"name",
+		"description",
+		"createDate",
+		"modifyDate",
+		"rules",
+		"networkComponentBindings.id",
+		"networkComponentBindings.networkComponentId",
+		"networkComponentBindings.networkComponent.guestId",
+	}
+
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnsgs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_SecurityGroup{}, errors.New(errorMessage)
+	}
+
+	securityGroup := datatypes.SoftLayer_Network_SecurityGroup{}
+	err = json.Unmarshal(response, &securityGroup)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	return securityGroup, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) GetAllObjects() ([]datatypes.SoftLayer_Network_SecurityGroup, error) {
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/getAllObjects.json", slnsgs.GetName()), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#getAllObjects, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_SecurityGroup{}, errors.New(errorMessage)
+	}
+
+	securityGroups := []datatypes.SoftLayer_Network_SecurityGroup{}
+	err = json.Unmarshal(response, &securityGroups)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_SecurityGroup{}, err
+	}
+
+	return securityGroups, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) EditObject(id int, template datatypes.SoftLayer_Network_SecurityGroup) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_SecurityGroup_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_SecurityGroup{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slnsgs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit security group with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) DeleteObject(id int) (bool, error) {
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slnsgs.GetName(), id), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#deleteObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete security group with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) GetRules(id int) ([]datatypes.SoftLayer_Network_SecurityGroup_Rule, error) {
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getRules.json", slnsgs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_SecurityGroup_Rule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#getRules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_SecurityGroup_Rule{}, errors.New(errorMessage)
+	}
+
+	rules := []datatypes.SoftLayer_Network_SecurityGroup_Rule{}
+	err = json.Unmarshal(response, &rules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_SecurityGroup_Rule{}, err
+	}
+
+	return rules, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) AddRules(id int, rules []datatypes.SoftLayer_Network_SecurityGroup_Rule) (datatypes.SoftLayer_Network_SecurityGroup_RequestRules, error) {
+	err := slnsgs.checkRules(rules, false)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_RequestRules{}, err
+	}
+
+	response, err := slnsgs.postParameters(id, "addRules", rules)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_RequestRules{}, err
+	}
+
+	requestRules := datatypes.SoftLayer_Network_SecurityGroup_RequestRules{}
+	err = json.Unmarshal(response, &requestRules)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_RequestRules{}, err
+	}
+
+	return requestRules, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) EditRules(id int, rules []datatypes.SoftLayer_Network_SecurityGroup_Rule) (datatypes.SoftLayer_Network_SecurityGroup_Request, error) {
+	err := slnsgs.checkRules(rules, true)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_Request{}, err
+	}
+
+	return slnsgs.postRequest(id, "editRules", rules)
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) RemoveRules(id int, ruleIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error) {
+	return slnsgs.postRequest(id, "removeRules", ruleIds)
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) AttachNetworkComponents(id int, networkComponentIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error) {
+	return slnsgs.postRequest(id, "attachNetworkComponents", networkComponentIds)
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) DetachNetworkComponents(id int, networkComponentIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error) {
+	return slnsgs.postRequest(id, "detachNetworkComponents", networkComponentIds)
+}
+
+//Private methods
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) postRequest(id int, method string, parameter interface{}) (datatypes.SoftLayer_Network_SecurityGroup_Request, error) {
+	response, err := slnsgs.postParameters(id, method, parameter)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_Request{}, err
+	}
+
+	request := datatypes.SoftLayer_Network_SecurityGroup_Request{}
+	err = json.Unmarshal(response, &request)
+	if err != nil {
+		return datatypes.SoftLayer_Network_SecurityGroup_Request{}, err
+	}
+
+	return request, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) postParameters(id int, method string, parameter interface{}) ([]byte, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Network_SecurityGroup_InitParameters{Parameters: []interface{}{parameter}})
+	if err != nil {
+		return nil, err
+	}
+
+	response, errorCode, err := slnsgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slnsgs.GetName(), id, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return nil, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_SecurityGroup#%s, HTTP error code: '%d'", method, errorCode)
+		return nil, errors.New(errorMessage)
+	}
+
+	err = slnsgs.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+func (slnsgs *softLayer_Network_SecurityGroup_Service) checkRules(rules []datatypes.SoftLayer_Network_SecurityGroup_Rule, requireId bool) error {
+	var err error
+	errorMessage := ""
+
+	for i, rule := range rules {
+		if requireId && rule.Id == 0 {
+			errorMessage += fmt.Sprintf("* rule %d: Id is required and cannot be empty\n", i)
+		}
+
+		if rule.Direction != "ingress" && rule.Direction != "egress" {
+			errorMessage += fmt.Sprintf("* rule %d: Direction must be 'ingress' or 'egress', got '%s'\n", i, rule.Direction)
+		}
+
+		if rule.Ethertype != "" && rule.Ethertype != "IPv4" && rule.Ethertype != "IPv6" {
+			errorMessage += fmt.Sprintf("* rule %d: Ethertype must be 'IPv4' or 'IPv6', got '%s'\n", i, rule.Ethertype)
+		}
+
+		if rule.Protocol != "" && rule.Protocol != "tcp" && rule.Protocol != "udp" && rule.Protocol != "icmp" {
+			errorMessage += fmt.Sprintf("* rule %d: Protocol must be 'tcp', 'udp' or 'icmp', got '%s'\n", i, rule.Protocol)
+		}
+
+		if (rule.PortRangeMin != nil || rule.PortRangeMax != nil) && rule.Protocol == "" {
+			errorMessage += fmt.Sprintf("* rule %d: Protocol is required when a port range is set\n", i)
+		}
+
+		// For icmp the port range carries the icmp type and code, which are not a range
+		if rule.Protocol != "icmp" && rule.PortRangeMin != nil && rule.PortRangeMax != nil && *rule.PortRangeMin > *rule.PortRangeMax {
+			errorMessage += fmt.Sprintf("* rule %d: PortRangeMin '%d' cannot be greater than PortRangeMax '%d'\n", i, *rule.PortRangeMin, *rule.PortRangeMax)
+		}
+
+		if rule.RemoteIp != "" && rule.RemoteGroupId != 0 {
+			errorMessage += fmt.Sprintf("* rule %d: RemoteIp and RemoteGroupId cannot both be set\n", i)
+		}
+
+		if rule.RemoteIp != "" && net.ParseIP(rule.RemoteIp) == nil {
+			if _, _, cidrErr := net.ParseCIDR(rule.RemoteIp); cidrErr != nil {
+				errorMessage += fmt.Sprintf("* rule %d: RemoteIp '%s' is not a valid IP address or CIDR\n", i, rule.RemoteIp)
+			}
+		}
+	}
+
+	if errorMessage != "" {
+		err = errors.New(errorMessage)
+	}
+
+	return err
+}
@@ -0,0 +1,553 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_SecurityGroup", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkSecurityGroupService softlayer.SoftLayer_Network_SecurityGroup_Service
+		err                         error
+
+		rule datatypes.SoftLayer_Network_SecurityGroup_Rule
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkSecurityGroupService, err = fakeClient.GetSoftLayer_Network_SecurityGroup_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkSecurityGroupService).ToNot(BeNil())
+
+		portRangeMin, portRangeMax := 22, 22
+		rule = datatypes.SoftLayer_Network_SecurityGroup_Rule{
+			Direction:    "ingress",
+			Ethertype:    "IPv4",
+			PortRangeMin: &portRangeMin,
+			PortRangeMax: &portRangeMax,
+			Protocol:     "tcp",
+			RemoteIp:     "10.0.0.0/8",
+		}
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkSecurityGroupService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_SecurityGroup"))
+		})
+	})
+
+	Context("#CreateObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_createObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates the security group with its rules", func() {
+			securityGroup, err := networkSecurityGroupService.CreateObject(datatypes.SoftLayer_Network_SecurityGroup{
+				Name:        "web-servers",
+				Description: "Allow HTTP and HTTPS",
+				Rules:       []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule},
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(securityGroup.Id).To(Equal(4455))
+			Expect(securityGroup.Name).To(Equal("web-servers"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"rules":[{"direction":"ingress","ethertype":"IPv4","portRangeMin":22,"portRangeMax":22,"protocol":"tcp","remoteIp":"10.0.0.0/8"}]`))
+		})
+
+		It("fails when the name is missing", func() {
+			_, err := networkSecurityGroupService.CreateObject(datatypes.SoftLayer_Network_SecurityGroup{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when a rule is invalid", func() {
+			rule.Direction = "inbound"
+			_, err := networkSecurityGroupService.CreateObject(datatypes.SoftLayer_Network_SecurityGroup{
+				Name:  "web-servers",
+				Rules: []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule},
+			})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.CreateObject(datatypes.SoftLayer_Network_SecurityGroup{Name: "web-servers"})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.CreateObject(datatypes.SoftLayer_Network_SecurityGroup{Name: "web-servers"})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the security group with its rules and bindings", func() {
+			securityGroup, err := networkSecurityGroupService.GetObject(4455)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(securityGroup.Id).To(Equal(4455))
+			Expect(securityGroup.Rules).To(HaveLen(2))
+			Expect(securityGroup.Rules[0].RemoteIp).To(Equal("0.0.0.0/0"))
+			Expect(securityGroup.Rules[1].RemoteGroupId).To(Equal(4456))
+			Expect(securityGroup.NetworkComponentBindings).To(HaveLen(1))
+			Expect(securityGroup.NetworkComponentBindings[0].NetworkComponentId).To(Equal(5566))
+			Expect(securityGroup.NetworkComponentBindings[0].NetworkComponent.GuestId).To(Equal(1234))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetObject(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetObject(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetAllObjects", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_getAllObjects.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns all the security groups of the account", func() {
+			securityGroups, err := networkSecurityGroupService.GetAllObjects()
+			Expect(err).ToNot(HaveOccurred())
+			Expect(securityGroups).To(HaveLen(2))
+			Expect(securityGroups[1].Name).To(Equal("load-balancers"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/getAllObjects.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetAllObjects()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetAllObjects()
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("edits the security group", func() {
+			edited, err := networkSecurityGroupService.EditObject(4455, datatypes.SoftLayer_Network_SecurityGroup{Name: "web"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[{"name":"web"}]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := networkSecurityGroupService.EditObject(4455, datatypes.SoftLayer_Network_SecurityGroup{Name: "web"})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.EditObject(4455, datatypes.SoftLayer_Network_SecurityGroup{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.EditObject(4455, datatypes.SoftLayer_Network_SecurityGroup{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DeleteObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("deletes the security group", func() {
+			deleted, err := networkSecurityGroupService.DeleteObject(4455)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+			_, err := networkSecurityGroupService.DeleteObject(4455)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.DeleteObject(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.DeleteObject(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the rules of the security group", func() {
+			rules, err := networkSecurityGroupService.GetRules(4455)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(2))
+			Expect(*rules[0].PortRangeMin).To(Equal(80))
+			Expect(rules[1].Direction).To(Equal("egress"))
+			Expect(rules[1].Ethertype).To(Equal("IPv6"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/getRules.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetRules(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.GetRules(4455)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AddRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_addRules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("adds the rules to the security group", func() {
+			requestRules, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(requestRules.RequestId).To(Equal(9901))
+			Expect(requestRules.Rules).To(HaveLen(1))
+			Expect(requestRules.Rules[0].Id).To(Equal(104))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/addRules.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[[{"direction":"ingress","ethertype":"IPv4","portRangeMin":22,"portRangeMax":22,"protocol":"tcp","remoteIp":"10.0.0.0/8"}]]}`))
+		})
+
+		It("fails when the port range is inverted", func() {
+			portRangeMin, portRangeMax := 443, 80
+			rule.PortRangeMin = &portRangeMin
+			rule.PortRangeMax = &portRangeMax
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("accepts an icmp type greater than the icmp code and sends zero values", func() {
+			icmpType, icmpCode := 8, 0
+			rule.Protocol = "icmp"
+			rule.PortRangeMin = &icmpType
+			rule.PortRangeMax = &icmpCode
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"portRangeMin":8,"portRangeMax":0,"protocol":"icmp"`))
+		})
+
+		It("fails when both remote ip and remote group are set", func() {
+			rule.RemoteGroupId = 4456
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the remote ip is not an address or CIDR", func() {
+			rule.RemoteIp = "not-an-ip"
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the ether type is unknown", func() {
+			rule.Ethertype = "IPX"
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when a port range is set without a protocol", func() {
+			rule.Protocol = ""
+			_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.AddRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_request.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("edits the rules of the security group", func() {
+			rule.Id = 101
+			request, err := networkSecurityGroupService.EditRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.RequestId).To(Equal(9902))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/editRules.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"id":101`))
+		})
+
+		It("fails when a rule has no id", func() {
+			_, err := networkSecurityGroupService.EditRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					rule.Id = 101
+
+					_, err := networkSecurityGroupService.EditRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					rule.Id = 101
+
+					_, err := networkSecurityGroupService.EditRules(4455, []datatypes.SoftLayer_Network_SecurityGroup_Rule{rule})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#RemoveRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_request.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("removes the rules from the security group", func() {
+			request, err := networkSecurityGroupService.RemoveRules(4455, []int{101, 102})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.RequestId).To(Equal(9902))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/removeRules.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[[101,102]]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.RemoveRules(4455, []int{101, 102})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.RemoveRules(4455, []int{101, 102})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AttachNetworkComponents", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_request.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("attaches the network components to the security group", func() {
+			request, err := networkSecurityGroupService.AttachNetworkComponents(4455, []int{5566, 5567})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.RequestId).To(Equal(9902))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/attachNetworkComponents.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[[5566,5567]]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.AttachNetworkComponents(4455, []int{5566, 5567})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.AttachNetworkComponents(4455, []int{5566, 5567})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DetachNetworkComponents", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_SecurityGroup_Service_request.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("detaches the network components from the security group", func() {
+			request, err := networkSecurityGroupService.DetachNetworkComponents(4455, []int{5566, 5567})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.RequestId).To(Equal(9902))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_SecurityGroup/4455/detachNetworkComponents.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[[5566,5567]]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.DetachNetworkComponents(4455, []int{5566, 5567})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSecurityGroupService.DetachNetworkComponents(4455, []int{5566, 5567})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 	"time"
 
 	boshlog "github.com/cloudfoundry/bosh-utils/logger"
@@ -19,11 +20,47 @@ import (
 )
 
 const (
-	NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID = 222
-	CREATE_ISCSI_VOLUME_MAX_RETRY_TIME     = 60
-	CREATE_ISCSI_VOLUME_CHECK_INTERVAL     = 10 // seconds
+	NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID  = 222
+	NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID = 759
+	CREATE_ISCSI_VOLUME_MAX_RETRY_TIME      = 60
+	CREATE_ISCSI_VOLUME_CHECK_INTERVAL      = 10 // seconds
+
+	NETWORK_PERFORMANCE_STORAGE_ISCSI_ORDER_COMPLEX_TYPE    = "SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi"
+	NETWORK_PERFORMANCE_STORAGE_NFS_ORDER_COMPLEX_TYPE      = "SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs"
+	NETWORK_STORAGE_AS_A_SERVICE_ORDER_COMPLEX_TYPE         = "SoftLayer_Container_Product_Order_Network_Storage_AsAService"
+	NETWORK_STORAGE_AS_A_SERVICE_UPGRADE_ORDER_COMPLEX_TYPE = "SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade"
+	NETWORK_STORAGE_SNAPSHOT_SPACE_ORDER_COMPLEX_TYPE       = "SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace"
+
+	STORAGE_TYPE_ENDURANCE   = "ENDURANCE"
+	STORAGE_TYPE_PERFORMANCE = "PERFORMANCE"
+
+	SNAPSHOT_SCHEDULE_HOURLY = "HOURLY"
+	SNAPSHOT_SCHEDULE_DAILY  = "DAILY"
+	SNAPSHOT_SCHEDULE_WEEKLY = "WEEKLY"
+
+	// Replication statuses end in one of these once provisioning, failover or failback is done
+	STORAGE_REPLICATION_STATUS_SUCCESS_SUFFIX   = "_SUCCESS"
+	STORAGE_REPLICATION_STATUS_COMPLETED_SUFFIX = "_COMPLETED"
+	STORAGE_REPLICATION_STATUS_FAILED_SUFFIX    = "_FAILED"
 )
 
+type enduranceStorageTier struct {
+	keyName string
+	level   int
+}
+
+// Endurance tiers by IOPS per GB, level is the value item prices of the tier restrict their capacity to
+var enduranceStorageTiers = map[float64]enduranceStorageTier{
+	0.25: enduranceStorageTier{keyName: "LOW_INTENSITY_TIER", level: 100},
+	2:    enduranceStorageTier{keyName: "READHEAVY_TIER", level: 200},
+	4:    enduranceStorageTier{keyName: "WRITEHEAVY_TIER", level: 300},
+	10:   enduranceStorageTier{keyName: "10_IOPS_PER_GB", level: 1000},
+}
+
+var networkStorageOsFormatTypes = []string{"LINUX", "VMWARE", "WINDOWS_2008", "WINDOWS_GPT", "WINDOWS", "XEN", "HYPER_V"}
+
+var snapshotScheduleDaysOfWeek = []string{"SUNDAY", "MONDAY", "TUESDAY", "WEDNESDAY", "THURSDAY", "FRIDAY", "SATURDAY"}
+
 type softLayer_Network_Storage_Service struct {
 	client softlayer.Client
 }
@@ -39,10 +76,19 @@ func (slns *softLayer_Network_Storage_Service) GetName() string {
 }
 
 func (slns *softLayer_Network_Storage_Service) CreateNetworkStorage(size int, capacity int, location string, useHourlyPricing bool) (datatypes.SoftLayer_Network_Storage, error) {
+	return slns.CreateNetworkStorageWithOsFormatType(size, capacity, location, useHourlyPricing, "LINUX")
+}
+
+func (slns *softLayer_Network_Storage_Service) CreateNetworkStorageWithOsFormatType(size int, capacity int, location string, useHourlyPricing bool, osFormatTypeKeyName string) (datatypes.SoftLayer_Network_Storage, error) {
 	if size < 0 {
 		return datatypes.SoftLayer_Network_Storage{}, errors.New("Cannot create negative sized volumes")
 	}
 
+	osFormatType, err := getNetworkStorageOsFormatType(osFormatTypeKeyName)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
 	sizeItemPriceId, err := slns.getIscsiVolumeItemIdBasedOnSize(size)
 	if err != nil {
 		return datatypes.SoftLayer_Network_Storage{}, err
@@ -66,12 +112,9 @@ func (slns *softLayer_Network_Storage_Service) CreateNetworkStorage(size int, ca
 	blockStorageItemPriceId, err := slns.getBlockStorageItemPriceId()
 
 	order := datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{
-		Location:    location,
-		ComplexType: "SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi",
-		OsFormatType: datatypes.SoftLayer_Network_Storage_Iscsi_OS_Type{
-			Id:      12,
-			KeyName: "LINUX",
-		},
+		Location:     location,
+		ComplexType:  "SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi",
+		OsFormatType: osFormatType,
 		Prices: []datatypes.SoftLayer_Product_Item_Price{
 			datatypes.SoftLayer_Product_Item_Price{
 				Id: sizeItemPriceId,
@@ -312,7 +355,7 @@ func (slns *softLayer_Network_Storage_Service) AttachNetworkStorageToVirtualGues
 
 	allowable, err := strconv.ParseBool(string(resp[:]))
 	if err != nil {
-		return false, nil
+		return false, err
 	}
 
 	return allowable, nil
@@ -342,7 +385,7 @@ func (slns *softLayer_Network_Storage_Service) AttachNetworkStorageToHardware(ha
 
 	allowable, err := strconv.ParseBool(string(resp[:]))
 	if err != nil {
-		return false, nil
+		return false, err
 	}
 
 	return allowable, nil
@@ -396,196 +439,1747 @@ func (slns *softLayer_Network_Storage_Service) DetachNetworkStorageFromHardware(
 	return nil
 }
 
-// Private methods
-
-func (slns *softLayer_Network_Storage_Service) findIscsiVolumeId(orderId int) (datatypes.SoftLayer_Network_Storage, error) {
-	ObjectFilter := string(`{"iscsiNetworkStorage":{"billingItem":{"orderItem":{"order":{"id":{"operation":` + strconv.Itoa(orderId) + `}}}}}}`)
+func (slns *softLayer_Network_Storage_Service) HasAllowedSubnet(volumeId int, subnetId int) (bool, error) {
+	filter := fmt.Sprintf(`{"allowedSubnets":{"id":{"operation":"%d"}}}`, subnetId)
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/%d/getAllowedSubnets.json", slns.GetName(), volumeId), []string{"id"}, filter, "GET", new(bytes.Buffer))
 
-	accountService, err := slns.client.GetSoftLayer_Account_Service()
 	if err != nil {
-		return datatypes.SoftLayer_Network_Storage{}, err
+		return false, errors.New(fmt.Sprintf("Cannot check authentication for volume %d in subnet %d", volumeId, subnetId))
 	}
 
-	iscsiStorages, err := accountService.GetIscsiNetworkStorageWithFilter(ObjectFilter)
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#hasAllowedSubnet, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	subnets := []datatypes.SoftLayer_Network_Subnet{}
+	err = json.Unmarshal(response, &subnets)
 	if err != nil {
-		return datatypes.SoftLayer_Network_Storage{}, err
+		return false, errors.New(fmt.Sprintf("Failed to unmarshal response of checking authentication for volume %d in subnet %d", volumeId, subnetId))
 	}
 
-	if len(iscsiStorages) == 1 {
-		return iscsiStorages[0], nil
+	if len(subnets) > 0 {
+		return true, nil
 	}
 
-	return datatypes.SoftLayer_Network_Storage{}, errors.New(fmt.Sprintf("Cannot find an performance storage (iSCSI volume) with order id %d", orderId))
+	return false, nil
 }
 
-func (slns *softLayer_Network_Storage_Service) getBlockStorageItemPriceId() (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) HasAllowedIpAddress(volumeId int, ipAddressId int) (bool, error) {
+	filter := fmt.Sprintf(`{"allowedIpAddresses":{"id":{"operation":"%d"}}}`, ipAddressId)
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectFilterAndObjectMask(fmt.Sprintf("%s/%d/getAllowedIpAddresses.json", slns.GetName(), volumeId), []string{"id"}, filter, "GET", new(bytes.Buffer))
+
 	if err != nil {
-		return 0, err
+		return false, errors.New(fmt.Sprintf("Cannot check authentication for volume %d in IP address %d", volumeId, ipAddressId))
 	}
 
-	filters := string(`{"items":{"categories":{"categoryCode":{"operation":"performance_storage_iscsi"}}}}`)
-	itemPrices, err := productPackageService.GetItems(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#hasAllowedIpAddress, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	ipAddresses := []datatypes.SoftLayer_Network_Subnet_IpAddress{}
+	err = json.Unmarshal(response, &ipAddresses)
 	if err != nil {
-		return 0, err
+		return false, errors.New(fmt.Sprintf("Failed to unmarshal response of checking authentication for volume %d in IP address %d", volumeId, ipAddressId))
 	}
 
-	if len(itemPrices) > 0 {
-		if len(itemPrices[0].Prices) > 0 {
-			return itemPrices[0].Prices[0].Id, nil
-		}
+	if len(ipAddresses) > 0 {
+		return true, nil
 	}
 
-	return 0, errors.New(fmt.Sprint("No proper block performance storage item price id"))
+	return false, nil
 }
 
-func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(size int) (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) AttachNetworkStorageToSubnet(subnet datatypes.SoftLayer_Network_Subnet, volumeId int) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Subnet_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Subnet{
+			subnet,
+		},
+	}
+	requestBody, err := json.Marshal(parameters)
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
-	keyName := strconv.Itoa(size) + "_GB_PERFORMANCE_STORAGE_SPACE"
-	filters := string(`{"itemPrices":{"item":{"keyName":{"operation":"` + keyName + `"}}}}`)
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	resp, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/allowAccessFromSubnet.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
+
 	if err != nil {
-		return 0, err
+		return false, err
 	}
 
-	var currentItemId int
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#attachNetworkStorageToSubnet, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
 
-	if len(itemPrices) > 0 {
-		for _, itemPrice := range itemPrices {
-			if itemPrice.LocationGroupId == 0 {
-				currentItemId = itemPrice.Id
-				break
-			}
-		}
+	allowable, err := strconv.ParseBool(string(resp[:]))
+	if err != nil {
+		return false, err
 	}
 
-	if currentItemId == 0 {
-		return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	return allowable, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) AttachNetworkStorageToIpAddress(ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress, volumeId int) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Subnet_IpAddress_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Subnet_IpAddress{
+			ipAddress,
+		},
 	}
-	return currentItemId, nil
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	resp, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/allowAccessFromIpAddress.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
+
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#attachNetworkStorageToIpAddress, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	allowable, err := strconv.ParseBool(string(resp[:]))
+	if err != nil {
+		return false, err
+	}
+
+	return allowable, nil
 }
 
-func (slns *softLayer_Network_Storage_Service) getItemPriceIdBySizeAndIops(size int, capacity int) (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) DetachNetworkStorageFromSubnet(subnet datatypes.SoftLayer_Network_Subnet, volumeId int) error {
+	parameters := datatypes.SoftLayer_Network_Subnet_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Subnet{
+			subnet,
+		},
+	}
+	requestBody, err := json.Marshal(parameters)
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	filters := fmt.Sprintf(`{"itemPrices":{"item":{"capacity":{"operation":%d}},"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, capacity, size)
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	_, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/removeAccessFromSubnet.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
 	if err != nil {
-		return 0, err
+		return err
 	}
 
-	var currentItemId int
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#detachNetworkStorageFromSubnet, HTTP error code: '%d'", errorCode)
+		return errors.New(errorMessage)
+	}
 
-	if len(itemPrices) > 0 {
-		for _, itemPrice := range itemPrices {
-			if itemPrice.LocationGroupId == 0 {
-				currentItemId = itemPrice.Id
-				break
-			}
-		}
+	return nil
+}
+
+func (slns *softLayer_Network_Storage_Service) DetachNetworkStorageFromIpAddress(ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress, volumeId int) error {
+	parameters := datatypes.SoftLayer_Network_Subnet_IpAddress_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Subnet_IpAddress{
+			ipAddress,
+		},
+	}
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return err
 	}
 
-	if currentItemId == 0 {
-		return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	_, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/removeAccessFromIpAddress.json", slns.GetName(), volumeId), "PUT", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return err
 	}
-	return currentItemId, nil
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#detachNetworkStorageFromIpAddress, HTTP error code: '%d'", errorCode)
+		return errors.New(errorMessage)
+	}
+
+	return nil
 }
 
-func (slns *softLayer_Network_Storage_Service) selectMaximunIopsItemPriceIdOnSize(size int) (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) OrderFileStorage(options *softlayer.NetworkStorageOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	err := slns.checkNetworkStorageOrderRequiredValues(options)
 	if err != nil {
-		return 0, err
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if strings.ToUpper(options.StorageType) == STORAGE_TYPE_ENDURANCE {
+		return slns.placeStorageAsAServiceOrder(options, "storage_file", nil)
+	}
+
+	prices, err := slns.findPerformanceStorageItemPrices(options, "performance_storage_nfs")
 	if err != nil {
-		return 0, err
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	if len(itemPrices) > 0 {
-		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
-			return itemPrice.LocationGroupId == 0
-		})
-		if len(candidates) > 0 {
-			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
-			return candidates[len(candidates)-1].Id, nil
-		} else {
-			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
-		}
+	order := datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs{
+		ComplexType:      NETWORK_PERFORMANCE_STORAGE_NFS_ORDER_COMPLEX_TYPE,
+		Location:         options.Location,
+		PackageId:        NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID,
+		Prices:           prices,
+		Quantity:         1,
+		UseHourlyPricing: options.UseHourlyPricing,
 	}
 
-	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkPerformanceStorageNfs(order)
 }
 
-func (slns *softLayer_Network_Storage_Service) selectMediumIopsItemPriceIdOnSize(size int) (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) OrderBlockStorage(options *softlayer.NetworkStorageOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	err := slns.checkNetworkStorageOrderRequiredValues(options)
 	if err != nil {
-		return 0, err
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	osFormatType, err := getNetworkStorageOsFormatType(options.OsFormatType)
 	if err != nil {
-		return 0, err
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
 	}
 
-	if len(itemPrices) > 0 {
-		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
-			return itemPrice.LocationGroupId == 0
-		})
-		if len(candidates) > 0 {
-			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
-			return candidates[len(candidates)/2].Id, nil
-		} else {
-			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
-		}
+	if strings.ToUpper(options.StorageType) == STORAGE_TYPE_ENDURANCE {
+		return slns.placeStorageAsAServiceOrder(options, "storage_block", osFormatType)
 	}
 
-	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	prices, err := slns.findPerformanceStorageItemPrices(options, "performance_storage_iscsi")
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi{
+		ComplexType:      NETWORK_PERFORMANCE_STORAGE_ISCSI_ORDER_COMPLEX_TYPE,
+		Location:         options.Location,
+		PackageId:        NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID,
+		Prices:           prices,
+		Quantity:         1,
+		OsFormatType:     osFormatType,
+		UseHourlyPricing: options.UseHourlyPricing,
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkPerformanceStorageIscsi(order)
 }
 
-func (slns *softLayer_Network_Storage_Service) selectMinimumIopsItemPriceIdOnSize(size int) (int, error) {
-	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+func (slns *softLayer_Network_Storage_Service) GetNetworkStorageOfferings(datacenter string) ([]softlayer.NetworkStorageOffering, error) {
+	if datacenter == "" {
+		return []softlayer.NetworkStorageOffering{}, errors.New("softlayer-go: datacenter is required to list network storage offerings")
+	}
+
+	offerings := []softlayer.NetworkStorageOffering{}
+
+	locationGroupIds, offered, err := slns.getStoragePackageLocationGroupIds(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, datacenter)
 	if err != nil {
-		return 0, err
+		return []softlayer.NetworkStorageOffering{}, err
 	}
 
-	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
-	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if offered {
+		itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, []string{"storage_tier_level", "performance_storage_space"})
+		if err != nil {
+			return []softlayer.NetworkStorageOffering{}, err
+		}
+		itemPrices = filterStorageItemPricesByLocationGroup(itemPrices, locationGroupIds)
+
+		tiers := []float64{}
+		for tier := range enduranceStorageTiers {
+			tiers = append(tiers, tier)
+		}
+		sort.Float64s(tiers)
+
+		for _, tier := range tiers {
+			tierPrices := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+				return hasItemPriceCategory(itemPrice, "storage_tier_level") && itemPrice.Item != nil && itemPrice.Item.KeyName == enduranceStorageTiers[tier].keyName
+			})
+			if len(tierPrices) == 0 {
+				continue
+			}
+
+			spaceKeyName := storageAsAServiceSpaceKeyName(tier)
+			for _, itemPrice := range itemPrices {
+				if !hasItemPriceCategory(itemPrice, "performance_storage_space") || itemPrice.Item == nil || itemPrice.Item.KeyName != spaceKeyName {
+					continue
+				}
+
+				minimum, maximum, ok := parseCapacityRange(itemPrice.Item.CapacityMinimum, itemPrice.Item.CapacityMaximum)
+				if ok {
+					offerings = appendNetworkStorageOffering(offerings, softlayer.NetworkStorageOffering{StorageType: STORAGE_TYPE_ENDURANCE, MinimumSize: minimum, MaximumSize: maximum, Tier: tier})
+				}
+			}
+		}
+	}
+
+	locationGroupIds, offered, err = slns.getStoragePackageLocationGroupIds(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, datacenter)
 	if err != nil {
-		return 0, err
+		return []softlayer.NetworkStorageOffering{}, err
 	}
 
-	if len(itemPrices) > 0 {
-		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
-			return itemPrice.LocationGroupId == 0
-		})
-		if len(candidates) > 0 {
-			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
-			return candidates[0].Id, nil
-		} else {
-			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	if offered {
+		itemPrices, err := slns.getStorageItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, []string{"performance_storage_space", "performance_storage_iops"})
+		if err != nil {
+			return []softlayer.NetworkStorageOffering{}, err
+		}
+		itemPrices = filterStorageItemPricesByLocationGroup(itemPrices, locationGroupIds)
+
+		// Pairs sizes and IOPS the same way findPerformanceStorageItemPrices orders them
+		for _, spacePrice := range itemPrices {
+			if !hasItemPriceCategory(spacePrice, "performance_storage_space") || spacePrice.Item == nil {
+				continue
+			}
+
+			size, err := strconv.Atoi(spacePrice.Item.Capacity)
+			if err != nil || spacePrice.Item.KeyName != fmt.Sprintf("%d_GB_PERFORMANCE_STORAGE_SPACE", size) {
+				continue
+			}
+
+			for _, iopsPrice := range itemPrices {
+				if !hasItemPriceCategory(iopsPrice, "performance_storage_iops") || iopsPrice.Item == nil {
+					continue
+				}
+
+				if iopsPrice.CapacityRestrictionType != "" && !isWithinCapacityRestriction(iopsPrice, "STORAGE_SPACE", size) {
+					continue
+				}
+
+				iops, err := strconv.Atoi(iopsPrice.Item.Capacity)
+				if err != nil {
+					continue
+				}
+
+				offerings = appendNetworkStorageOffering(offerings, softlayer.NetworkStorageOffering{StorageType: STORAGE_TYPE_PERFORMANCE, MinimumSize: size, MaximumSize: size, Iops: iops})
+			}
 		}
 	}
 
-	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	if len(offerings) == 0 {
+		return []softlayer.NetworkStorageOffering{}, errors.New(fmt.Sprintf("softlayer-go: no network storage is offered in datacenter '%s'", datacenter))
+	}
+
+	return offerings, nil
 }
 
-func filter(vs []datatypes.SoftLayer_Product_Item_Price, f func(datatypes.SoftLayer_Product_Item_Price) bool) []datatypes.SoftLayer_Product_Item_Price {
-	vsf := make([]datatypes.SoftLayer_Product_Item_Price, 0)
-	for _, v := range vs {
-		if f(v) {
-			vsf = append(vsf, v)
-		}
+func (slns *softLayer_Network_Storage_Service) GetFileNetworkMountAddress(volumeId int) (string, error) {
+	objectMask := []string{
+		"id",
+		"nasType",
+		"fileNetworkMountAddress",
 	}
 
-	return vsf
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getObject, HTTP error code: '%d'", errorCode)
+		return "", errors.New(errorMessage)
+	}
+
+	volume := datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &volume)
+	if err != nil {
+		return "", err
+	}
+
+	if volume.NasType != "NAS" {
+		return "", errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' is not a file storage volume, got nas type '%s'", volumeId, volume.NasType))
+	}
+
+	if volume.FileNetworkMountAddress == "" {
+		return "", errors.New(fmt.Sprintf("softlayer-go: file storage volume with id '%d' has no mount address yet", volumeId))
+	}
+
+	return volume.FileNetworkMountAddress, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) OrderSnapshotSpace(volumeId int, size int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	if size <= 0 {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: snapshot space size must be a positive number of GB, got '%d'", size))
+	}
+
+	volume, err := slns.getOrderOriginVolume(volumeId)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	err = checkStorageAsAServiceVolume(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	_, tier, iops, err := resolveVolumeCapacity(volume, 0, 0, 0)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, []string{"storage_snapshot_space"})
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	snapshotSpacePrice, err := findSnapshotSpaceItemPrice(itemPrices, tier, iops, size)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace{
+		ComplexType: NETWORK_STORAGE_SNAPSHOT_SPACE_ORDER_COMPLEX_TYPE,
+		PackageId:   NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID,
+		Prices: []datatypes.SoftLayer_Product_Item_Price{
+			datatypes.SoftLayer_Product_Item_Price{Id: snapshotSpacePrice.Id},
+		},
+		Quantity: 1,
+		VolumeId: volumeId,
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(order)
+}
+
+func (slns *softLayer_Network_Storage_Service) CreateSnapshot(volumeId int, notes string) (datatypes.SoftLayer_Network_Storage, error) {
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			notes,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/createSnapshot.json", slns.GetName(), volumeId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#createSnapshot, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+	}
+
+	err = slns.client.GetHttpClient().CheckForHttpResponseErrors(response)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	snapshot := datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &snapshot)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return snapshot, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) GetSnapshots(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error) {
+	objectMask := []string{
+		"id",
+		"notes",
+		"createDate",
+		"snapshotCreationTimestamp",
+		"snapshotSizeBytes",
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getSnapshots.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getSnapshots, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+	}
+
+	snapshots := []datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &snapshots)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return snapshots, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) DeleteSnapshot(snapshotId int) (bool, error) {
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d.json", slns.GetName(), snapshotId), "DELETE", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#deleteSnapshot, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to delete snapshot with id '%d', got '%s' as response from the API.", snapshotId, res))
+	}
+
+	return true, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) RestoreFromSnapshot(volumeId int, snapshotId int) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			snapshotId,
+		},
+	}
+
+	return slns.callStorageBoolMethod(volumeId, "restoreFromSnapshot", parameters, fmt.Sprintf("restore volume with id '%d' from snapshot with id '%d'", volumeId, snapshotId))
+}
+
+func (slns *softLayer_Network_Storage_Service) EnableSnapshots(volumeId int, options *softlayer.SnapshotScheduleOptions) (bool, error) {
+	err := checkSnapshotScheduleOptions(options)
+	if err != nil {
+		return false, err
+	}
+
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			strings.ToUpper(options.ScheduleType),
+			options.RetentionCount,
+			options.Minute,
+			options.Hour,
+			strings.ToUpper(options.DayOfWeek),
+		},
+	}
+
+	return slns.callStorageBoolMethod(volumeId, "enableSnapshots", parameters, fmt.Sprintf("enable %s snapshots for volume with id '%d'", strings.ToLower(options.ScheduleType), volumeId))
+}
+
+func (slns *softLayer_Network_Storage_Service) DisableSnapshots(volumeId int, scheduleType string) (bool, error) {
+	scheduleType = strings.ToUpper(scheduleType)
+	if scheduleType != SNAPSHOT_SCHEDULE_HOURLY && scheduleType != SNAPSHOT_SCHEDULE_DAILY && scheduleType != SNAPSHOT_SCHEDULE_WEEKLY {
+		return false, errors.New(fmt.Sprintf("softlayer-go: unsupported snapshot schedule type '%s', expected '%s', '%s' or '%s'", scheduleType, SNAPSHOT_SCHEDULE_HOURLY, SNAPSHOT_SCHEDULE_DAILY, SNAPSHOT_SCHEDULE_WEEKLY))
+	}
+
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			scheduleType,
+		},
+	}
+
+	return slns.callStorageBoolMethod(volumeId, "disableSnapshots", parameters, fmt.Sprintf("disable %s snapshots for volume with id '%d'", strings.ToLower(scheduleType), volumeId))
+}
+
+func (slns *softLayer_Network_Storage_Service) GetSnapshotSchedules(volumeId int) ([]datatypes.SoftLayer_Network_Storage_Schedule, error) {
+	objectMask := []string{
+		"id",
+		"name",
+		"active",
+		"retentionCount",
+		"minuteOfHour",
+		"hourOfDay",
+		"dayOfWeek",
+		"type.id",
+		"type.keyname",
+		"type.name",
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getSchedules.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage_Schedule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getSchedules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Storage_Schedule{}, errors.New(errorMessage)
+	}
+
+	schedules := []datatypes.SoftLayer_Network_Storage_Schedule{}
+	err = json.Unmarshal(response, &schedules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage_Schedule{}, err
+	}
+
+	return schedules, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) OrderReplicaVolume(volumeId int, options *softlayer.ReplicaOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	err := checkReplicaOrderOptions(options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	volume, err := slns.getOrderOriginVolume(volumeId)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	err = checkStorageAsAServiceVolume(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	_, tier, iops, err := resolveVolumeCapacity(volume, 0, 0, 0)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	snapshotCapacity, err := strconv.Atoi(volume.SnapshotCapacityGb)
+	if err != nil || snapshotCapacity <= 0 {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' has no snapshot space, order snapshot space before ordering a replica", volumeId))
+	}
+
+	scheduleKeyName := "SNAPSHOT_" + strings.ToUpper(options.ScheduleType)
+	scheduleId := 0
+	for _, schedule := range volume.Schedules {
+		if schedule.Type != nil && schedule.Type.Keyname == scheduleKeyName {
+			scheduleId = schedule.Id
+			break
+		}
+	}
+	if scheduleId == 0 {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' has no %s snapshot schedule to replicate", volumeId, strings.ToLower(options.ScheduleType)))
+	}
+
+	storageCategory, osFormatType, err := getStorageAsAServiceCategory(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	categories := append([]string{"storage_as_a_service", storageCategory}, storageAsAServiceVolumeCategories(volume)...)
+	categories = append(categories, "storage_snapshot_space", "performance_storage_replication")
+
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, categories)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	servicePrice, err := findStorageItemPrice(itemPrices, "storage_as_a_service", anyStorageItemPrice)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	storagePrice, err := findStorageItemPrice(itemPrices, storageCategory, anyStorageItemPrice)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	volumePrices, err := findVolumeStorageAsAServiceItemPrices(itemPrices, volume, volume.CapacityGb, tier, iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	snapshotSpacePrice, err := findSnapshotSpaceItemPrice(itemPrices, tier, iops, snapshotCapacity)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	replicationPrice, err := findReplicationItemPrice(itemPrices, tier, iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	prices := append([]datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: servicePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: storagePrice.Id},
+	}, volumePrices...)
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{
+		ComplexType: NETWORK_STORAGE_AS_A_SERVICE_ORDER_COMPLEX_TYPE,
+		Location:    options.Location,
+		PackageId:   NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID,
+		Prices: append(prices,
+			datatypes.SoftLayer_Product_Item_Price{Id: snapshotSpacePrice.Id},
+			datatypes.SoftLayer_Product_Item_Price{Id: replicationPrice.Id},
+		),
+		Quantity:               1,
+		VolumeSize:             volume.CapacityGb,
+		Iops:                   iops,
+		OsFormatType:           osFormatType,
+		OriginVolumeId:         volumeId,
+		OriginVolumeScheduleId: scheduleId,
+		UseHourlyPricing:       options.UseHourlyPricing,
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkStorageAsAService(order)
+}
+
+func (slns *softLayer_Network_Storage_Service) GetReplicantVolumes(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slns.getReplicationVolumes(volumeId, "getReplicants")
+}
+
+func (slns *softLayer_Network_Storage_Service) GetReplicationPartners(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error) {
+	return slns.getReplicationVolumes(volumeId, "getReplicationPartners")
+}
+
+func (slns *softLayer_Network_Storage_Service) FailoverToReplicant(volumeId int, replicantId int, immediate bool) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			replicantId,
+			immediate,
+		},
+	}
+
+	return slns.callStorageBoolMethod(volumeId, "failoverToReplicant", parameters, fmt.Sprintf("fail over volume with id '%d' to replicant with id '%d'", volumeId, replicantId))
+}
+
+func (slns *softLayer_Network_Storage_Service) FailbackFromReplicant(volumeId int, replicantId int) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Storage_InitParameters{
+		Parameters: []interface{}{
+			replicantId,
+		},
+	}
+
+	return slns.callStorageBoolMethod(volumeId, "failbackFromReplicant", parameters, fmt.Sprintf("fail back volume with id '%d' from replicant with id '%d'", volumeId, replicantId))
+}
+
+func (slns *softLayer_Network_Storage_Service) GetActiveTransactions(volumeId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	objectMask := []string{
+		"id",
+		"createDate",
+		"elapsedSeconds",
+		"transactionStatus.name",
+		"transactionStatus.friendlyName",
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getActiveTransactions.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getActiveTransactions, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+	}
+
+	activeTransactions := []datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	err = json.Unmarshal(response, &activeTransactions)
+	if err != nil {
+		return []datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	return activeTransactions, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) WaitForReplication(volumeId int) error {
+	SL_STORAGE_REPLICATION_TIMEOUT, err := strconv.Atoi(os.Getenv("SL_STORAGE_REPLICATION_TIMEOUT"))
+	if err != nil || SL_STORAGE_REPLICATION_TIMEOUT == 0 {
+		SL_STORAGE_REPLICATION_TIMEOUT = 3600
+	}
+	SL_STORAGE_REPLICATION_POLLING_INTERVAL, err := strconv.Atoi(os.Getenv("SL_STORAGE_REPLICATION_POLLING_INTERVAL"))
+	if err != nil || SL_STORAGE_REPLICATION_POLLING_INTERVAL == 0 {
+		SL_STORAGE_REPLICATION_POLLING_INTERVAL = 10
+	}
+
+	execStmtRetryable := boshretry.NewRetryable(
+		func() (bool, error) {
+			//The replication status is only updated once the transactions started by the last replication operation are done
+			activeTransactions, err := slns.GetActiveTransactions(volumeId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get active transactions of volume with id `%d` due to `%s`, retrying...", volumeId, err.Error()))
+			}
+
+			for _, activeTransaction := range activeTransactions {
+				if isReplicationTransaction(activeTransaction) {
+					return true, errors.New(fmt.Sprintf("Volume with id `%d` is still running transaction `%s`, retrying...", volumeId, activeTransaction.TransactionStatus.Name))
+				}
+			}
+
+			replicationStatus, err := slns.getReplicationStatus(volumeId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get replication status of volume with id `%d` due to `%s`, retrying...", volumeId, err.Error()))
+			}
+
+			switch {
+			case strings.HasSuffix(replicationStatus, STORAGE_REPLICATION_STATUS_SUCCESS_SUFFIX), strings.HasSuffix(replicationStatus, STORAGE_REPLICATION_STATUS_COMPLETED_SUFFIX):
+				return false, nil
+			case strings.HasSuffix(replicationStatus, STORAGE_REPLICATION_STATUS_FAILED_SUFFIX):
+				return false, errors.New(fmt.Sprintf("Replication of volume with id `%d` failed with status `%s`", volumeId, replicationStatus))
+			}
+
+			return true, errors.New(fmt.Sprintf("Replication of volume with id `%d` has status `%s`, retrying...", volumeId, replicationStatus))
+		})
+	timeService := clock.NewClock()
+	timeoutRetryStrategy := boshretry.NewTimeoutRetryStrategy(time.Duration(SL_STORAGE_REPLICATION_TIMEOUT)*time.Second, time.Duration(SL_STORAGE_REPLICATION_POLLING_INTERVAL)*time.Second, execStmtRetryable, timeService, boshlog.NewLogger(boshlog.LevelInfo))
+	err = timeoutRetryStrategy.Try()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to wait for replication of volume with id `%d` within `%d` seconds: %s", volumeId, SL_STORAGE_REPLICATION_TIMEOUT, err.Error()))
+	}
+
+	return nil
+}
+
+func (slns *softLayer_Network_Storage_Service) OrderModifiedVolume(volumeId int, options *softlayer.NetworkStorageModifyOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	if options == nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New("softlayer-go: volume modification options are required")
+	}
+
+	volume, err := slns.getOrderOriginVolume(volumeId)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	err = checkStorageAsAServiceVolume(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	currentSize, currentTier, currentIops, err := resolveVolumeCapacity(volume, 0, 0, 0)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	size, tier, iops, err := resolveVolumeCapacity(volume, options.Size, options.Tier, options.Iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if size < currentSize {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' can not shrink from '%d' GB to '%d' GB", volumeId, currentSize, size))
+	}
+
+	if size == currentSize && tier == currentTier && iops == currentIops {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' already has the requested size, tier and IOPS", volumeId))
+	}
+
+	categories := append([]string{"storage_as_a_service"}, storageAsAServiceVolumeCategories(volume)...)
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, categories)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	servicePrice, err := findStorageItemPrice(itemPrices, "storage_as_a_service", anyStorageItemPrice)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	volumePrices, err := findVolumeStorageAsAServiceItemPrices(itemPrices, volume, size, tier, iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade{
+		ComplexType: NETWORK_STORAGE_AS_A_SERVICE_UPGRADE_ORDER_COMPLEX_TYPE,
+		PackageId:   NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID,
+		Prices: append([]datatypes.SoftLayer_Product_Item_Price{
+			datatypes.SoftLayer_Product_Item_Price{Id: servicePrice.Id},
+		}, volumePrices...),
+		VolumeSize: size,
+		Iops:       iops,
+		Volume:     &datatypes.Volume{Id: volumeId},
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkStorageAsAServiceUpgrade(order)
+}
+
+func (slns *softLayer_Network_Storage_Service) OrderDuplicateVolume(volumeId int, options *softlayer.NetworkStorageDuplicateOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	if options == nil {
+		options = &softlayer.NetworkStorageDuplicateOptions{}
+	}
+
+	if options.SnapshotSize < 0 {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: snapshot space size must be a positive number of GB, got '%d'", options.SnapshotSize))
+	}
+
+	volume, err := slns.getOrderOriginVolume(volumeId)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	err = checkStorageAsAServiceVolume(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	size, tier, iops, err := resolveVolumeCapacity(volume, options.Size, options.Tier, options.Iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if size < volume.CapacityGb {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: duplicate of volume with id '%d' can not be smaller than '%d' GB, got '%d' GB", volumeId, volume.CapacityGb, size))
+	}
+
+	if volume.ServiceResource == nil || volume.ServiceResource.Datacenter == nil || volume.ServiceResource.Datacenter.Name == "" {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("softlayer-go: could not get the datacenter of volume with id '%d'", volumeId))
+	}
+
+	storageCategory, osFormatType, err := getStorageAsAServiceCategory(volume)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	categories := append([]string{"storage_as_a_service", storageCategory}, storageAsAServiceVolumeCategories(volume)...)
+	if options.SnapshotSize > 0 {
+		categories = append(categories, "storage_snapshot_space")
+	}
+
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, categories)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	servicePrice, err := findStorageItemPrice(itemPrices, "storage_as_a_service", anyStorageItemPrice)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	storagePrice, err := findStorageItemPrice(itemPrices, storageCategory, anyStorageItemPrice)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	volumePrices, err := findVolumeStorageAsAServiceItemPrices(itemPrices, volume, size, tier, iops)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	prices := append([]datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: servicePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: storagePrice.Id},
+	}, volumePrices...)
+
+	if options.SnapshotSize > 0 {
+		snapshotSpacePrice, err := findSnapshotSpaceItemPrice(itemPrices, tier, iops, options.SnapshotSize)
+		if err != nil {
+			return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+		}
+
+		prices = append(prices, datatypes.SoftLayer_Product_Item_Price{Id: snapshotSpacePrice.Id})
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{
+		ComplexType:               NETWORK_STORAGE_AS_A_SERVICE_ORDER_COMPLEX_TYPE,
+		Location:                  volume.ServiceResource.Datacenter.Name,
+		PackageId:                 NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID,
+		Prices:                    prices,
+		Quantity:                  1,
+		VolumeSize:                size,
+		Iops:                      iops,
+		OsFormatType:              osFormatType,
+		DuplicateOriginVolumeId:   volumeId,
+		DuplicateOriginSnapshotId: options.SnapshotId,
+		UseHourlyPricing:          options.UseHourlyPricing,
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkStorageAsAService(order)
+}
+
+// Private methods
+
+func (slns *softLayer_Network_Storage_Service) findIscsiVolumeId(orderId int) (datatypes.SoftLayer_Network_Storage, error) {
+	ObjectFilter := string(`{"iscsiNetworkStorage":{"billingItem":{"orderItem":{"order":{"id":{"operation":` + strconv.Itoa(orderId) + `}}}}}}`)
+
+	accountService, err := slns.client.GetSoftLayer_Account_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	iscsiStorages, err := accountService.GetIscsiNetworkStorageWithFilter(ObjectFilter)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	if len(iscsiStorages) == 1 {
+		return iscsiStorages[0], nil
+	}
+
+	return datatypes.SoftLayer_Network_Storage{}, errors.New(fmt.Sprintf("Cannot find an performance storage (iSCSI volume) with order id %d", orderId))
+}
+
+func (slns *softLayer_Network_Storage_Service) getBlockStorageItemPriceId() (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	filters := string(`{"items":{"categories":{"categoryCode":{"operation":"performance_storage_iscsi"}}}}`)
+	itemPrices, err := productPackageService.GetItems(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(itemPrices) > 0 {
+		if len(itemPrices[0].Prices) > 0 {
+			return itemPrices[0].Prices[0].Id, nil
+		}
+	}
+
+	return 0, errors.New(fmt.Sprint("No proper block performance storage item price id"))
+}
+
+func (slns *softLayer_Network_Storage_Service) getIscsiVolumeItemIdBasedOnSize(size int) (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	keyName := strconv.Itoa(size) + "_GB_PERFORMANCE_STORAGE_SPACE"
+	filters := string(`{"itemPrices":{"item":{"keyName":{"operation":"` + keyName + `"}}}}`)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	var currentItemId int
+
+	if len(itemPrices) > 0 {
+		for _, itemPrice := range itemPrices {
+			if itemPrice.LocationGroupId == 0 {
+				currentItemId = itemPrice.Id
+				break
+			}
+		}
+	}
+
+	if currentItemId == 0 {
+		return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	}
+	return currentItemId, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) getItemPriceIdBySizeAndIops(size int, capacity int) (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"item":{"capacity":{"operation":%d}},"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, capacity, size)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	var currentItemId int
+
+	if len(itemPrices) > 0 {
+		for _, itemPrice := range itemPrices {
+			if itemPrice.LocationGroupId == 0 {
+				currentItemId = itemPrice.Id
+				break
+			}
+		}
+	}
+
+	if currentItemId == 0 {
+		return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+	}
+	return currentItemId, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) selectMaximunIopsItemPriceIdOnSize(size int) (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(itemPrices) > 0 {
+		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+			return itemPrice.LocationGroupId == 0
+		})
+		if len(candidates) > 0 {
+			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
+			return candidates[len(candidates)-1].Id, nil
+		} else {
+			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+}
+
+func (slns *softLayer_Network_Storage_Service) selectMediumIopsItemPriceIdOnSize(size int) (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(itemPrices) > 0 {
+		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+			return itemPrice.LocationGroupId == 0
+		})
+		if len(candidates) > 0 {
+			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
+			return candidates[len(candidates)/2].Id, nil
+		} else {
+			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+}
+
+func (slns *softLayer_Network_Storage_Service) selectMinimumIopsItemPriceIdOnSize(size int) (int, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return 0, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"attributes":{"value":{"operation":%d}},"categories":{"categoryCode":{"operation":"performance_storage_iops"}}}}`, size)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, filters)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(itemPrices) > 0 {
+		candidates := filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+			return itemPrice.LocationGroupId == 0
+		})
+		if len(candidates) > 0 {
+			sort.Sort(datatypes.SoftLayer_Product_Item_Price_Sorted_Data(candidates))
+			return candidates[0].Id, nil
+		} else {
+			return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("No proper performance storage (iSCSI volume)for size %d", size))
+}
+
+func filter(vs []datatypes.SoftLayer_Product_Item_Price, f func(datatypes.SoftLayer_Product_Item_Price) bool) []datatypes.SoftLayer_Product_Item_Price {
+	vsf := make([]datatypes.SoftLayer_Product_Item_Price, 0)
+	for _, v := range vs {
+		if f(v) {
+			vsf = append(vsf, v)
+		}
+	}
+
+	return vsf
+}
+
+func (slns *softLayer_Network_Storage_Service) checkNetworkStorageOrderRequiredValues(options *softlayer.NetworkStorageOrderOptions) error {
+	if options == nil {
+		return errors.New("softlayer-go: network storage order options are required")
+	}
+
+	if options.Location == "" {
+		return errors.New("softlayer-go: location is required to order network storage")
+	}
+
+	if options.Size <= 0 {
+		return errors.New(fmt.Sprintf("softlayer-go: network storage size must be a positive number of GB, got '%d'", options.Size))
+	}
+
+	switch strings.ToUpper(options.StorageType) {
+	case STORAGE_TYPE_ENDURANCE:
+		if _, ok := enduranceStorageTiers[options.Tier]; !ok {
+			return errors.New(fmt.Sprintf("softlayer-go: unsupported endurance tier '%v', expected 0.25, 2, 4 or 10 IOPS per GB", options.Tier))
+		}
+	case STORAGE_TYPE_PERFORMANCE:
+		if options.Iops <= 0 {
+			return errors.New("softlayer-go: IOPS are required to order performance storage")
+		}
+	default:
+		return errors.New(fmt.Sprintf("softlayer-go: unsupported storage type '%s', expected '%s' or '%s'", options.StorageType, STORAGE_TYPE_ENDURANCE, STORAGE_TYPE_PERFORMANCE))
+	}
+
+	return nil
+}
+
+func (slns *softLayer_Network_Storage_Service) placeStorageAsAServiceOrder(options *softlayer.NetworkStorageOrderOptions, storageCategory string, osFormatType *datatypes.SoftLayer_Network_Storage_Iscsi_OS_Type_Template) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID, []string{"storage_as_a_service", storageCategory, "storage_tier_level", "performance_storage_space"})
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	prices, err := findStorageAsAServiceItemPrices(itemPrices, storageCategory, options.Tier, options.Size)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{
+		ComplexType:      NETWORK_STORAGE_AS_A_SERVICE_ORDER_COMPLEX_TYPE,
+		Location:         options.Location,
+		PackageId:        NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID,
+		Prices:           prices,
+		Quantity:         1,
+		VolumeSize:       options.Size,
+		OsFormatType:     osFormatType,
+		UseHourlyPricing: options.UseHourlyPricing,
+	}
+
+	productOrderService, err := slns.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkStorageAsAService(order)
+}
+
+func findStorageAsAServiceItemPrices(itemPrices []datatypes.SoftLayer_Product_Item_Price, storageCategory string, tier float64, size int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	servicePrice, err := findStorageItemPrice(itemPrices, "storage_as_a_service", anyStorageItemPrice)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	storagePrice, err := findStorageItemPrice(itemPrices, storageCategory, anyStorageItemPrice)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	endurancePrices, err := findEnduranceStorageAsAServiceItemPrices(itemPrices, tier, size)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	return append([]datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: servicePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: storagePrice.Id},
+	}, endurancePrices...), nil
+}
+
+func findEnduranceStorageAsAServiceItemPrices(itemPrices []datatypes.SoftLayer_Product_Item_Price, tier float64, size int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	tierPrice, err := findStorageItemPrice(itemPrices, "storage_tier_level", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		return itemPrice.Item != nil && itemPrice.Item.KeyName == enduranceStorageTiers[tier].keyName
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	spaceKeyName := storageAsAServiceSpaceKeyName(tier)
+	spacePrice, err := findStorageItemPrice(itemPrices, "performance_storage_space", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.Item == nil || itemPrice.Item.KeyName != spaceKeyName {
+			return false
+		}
+
+		minimum, maximum, ok := parseCapacityRange(itemPrice.Item.CapacityMinimum, itemPrice.Item.CapacityMaximum)
+		return ok && minimum <= size && size <= maximum
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	return []datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: tierPrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: spacePrice.Id},
+	}, nil
+}
+
+func findPerformanceStorageAsAServiceItemPrices(itemPrices []datatypes.SoftLayer_Product_Item_Price, size int, iops int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	spacePrice, err := findStorageItemPrice(itemPrices, "performance_storage_space", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.Item == nil || itemPrice.CapacityRestrictionType != "" {
+			return false
+		}
+
+		minimum, maximum, ok := parseCapacityRange(itemPrice.Item.CapacityMinimum, itemPrice.Item.CapacityMaximum)
+		return ok && itemPrice.Item.KeyName == fmt.Sprintf("%d_%d_GBS", minimum, maximum) && minimum <= size && size <= maximum
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	iopsPrice, err := findStorageItemPrice(itemPrices, "performance_storage_iops", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.Item == nil {
+			return false
+		}
+
+		minimum, maximum, ok := parseCapacityRange(itemPrice.Item.CapacityMinimum, itemPrice.Item.CapacityMaximum)
+		return ok && minimum <= iops && iops <= maximum && isWithinCapacityRestriction(itemPrice, "STORAGE_SPACE", size)
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	return []datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: spacePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: iopsPrice.Id},
+	}, nil
+}
+
+func findSnapshotSpaceItemPrice(itemPrices []datatypes.SoftLayer_Product_Item_Price, tier float64, iops int, size int) (datatypes.SoftLayer_Product_Item_Price, error) {
+	return findStorageItemPrice(itemPrices, "storage_snapshot_space", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.Item == nil || itemPrice.Item.Capacity != strconv.Itoa(size) {
+			return false
+		}
+
+		if iops != 0 {
+			return isWithinCapacityRestriction(itemPrice, "IOPS", iops)
+		}
+
+		return isWithinCapacityRestriction(itemPrice, "STORAGE_TIER_LEVEL", enduranceStorageTiers[tier].level)
+	})
+}
+
+func findReplicationItemPrice(itemPrices []datatypes.SoftLayer_Product_Item_Price, tier float64, iops int) (datatypes.SoftLayer_Product_Item_Price, error) {
+	return findStorageItemPrice(itemPrices, "performance_storage_replication", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if iops != 0 {
+			return isWithinCapacityRestriction(itemPrice, "IOPS", iops)
+		}
+
+		return isWithinCapacityRestriction(itemPrice, "STORAGE_TIER_LEVEL", enduranceStorageTiers[tier].level)
+	})
+}
+
+func (slns *softLayer_Network_Storage_Service) findPerformanceStorageItemPrices(options *softlayer.NetworkStorageOrderOptions, storageCategory string) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	itemPrices, err := slns.getStorageItemPrices(NETWORK_PERFORMANCE_STORAGE_PACKAGE_ID, []string{storageCategory, "performance_storage_space", "performance_storage_iops"})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	storagePrice, err := findStorageItemPrice(itemPrices, storageCategory, anyStorageItemPrice)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	keyName := fmt.Sprintf("%d_GB_PERFORMANCE_STORAGE_SPACE", options.Size)
+	spacePrice, err := findStorageItemPrice(itemPrices, "performance_storage_space", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		return itemPrice.Item != nil && itemPrice.Item.KeyName == keyName
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	iopsPrice, err := findStorageItemPrice(itemPrices, "performance_storage_iops", func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.Item == nil || itemPrice.Item.Capacity != strconv.Itoa(options.Iops) {
+			return false
+		}
+
+		return itemPrice.CapacityRestrictionType == "" || isWithinCapacityRestriction(itemPrice, "STORAGE_SPACE", options.Size)
+	})
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	return []datatypes.SoftLayer_Product_Item_Price{
+		datatypes.SoftLayer_Product_Item_Price{Id: storagePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: spacePrice.Id},
+		datatypes.SoftLayer_Product_Item_Price{Id: iopsPrice.Id},
+	}, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) getStoragePackageLocationGroupIds(packageId int, datacenter string) ([]int, bool, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return []int{}, false, err
+	}
+
+	regions, err := productPackageService.GetRegions(packageId)
+	if err != nil {
+		return []int{}, false, err
+	}
+
+	for _, region := range regions {
+		if region.Location != nil && region.Location.Location != nil && strings.EqualFold(region.Location.Location.Name, datacenter) {
+			locationGroupIds := []int{}
+			for _, priceGroup := range region.Location.Location.PriceGroups {
+				locationGroupIds = append(locationGroupIds, priceGroup.Id)
+			}
+
+			return locationGroupIds, true, nil
+		}
+	}
+
+	return []int{}, false, nil
+}
+
+func filterStorageItemPricesByLocationGroup(itemPrices []datatypes.SoftLayer_Product_Item_Price, locationGroupIds []int) []datatypes.SoftLayer_Product_Item_Price {
+	return filter(itemPrices, func(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+		if itemPrice.LocationGroupId == 0 {
+			return true
+		}
+
+		for _, locationGroupId := range locationGroupIds {
+			if itemPrice.LocationGroupId == locationGroupId {
+				return true
+			}
+		}
+
+		return false
+	})
+}
+
+func appendNetworkStorageOffering(offerings []softlayer.NetworkStorageOffering, offering softlayer.NetworkStorageOffering) []softlayer.NetworkStorageOffering {
+	for _, existing := range offerings {
+		if existing == offering {
+			return offerings
+		}
+	}
+
+	return append(offerings, offering)
+}
+
+func (slns *softLayer_Network_Storage_Service) getStorageItemPrices(packageId int, categoryCodes []string) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	productPackageService, err := slns.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["%s"]}]}}}}`, strings.Join(categoryCodes, `","`))
+
+	return productPackageService.GetItemPrices(packageId, filters)
+}
+
+func findStorageItemPrice(itemPrices []datatypes.SoftLayer_Product_Item_Price, categoryCode string, match func(datatypes.SoftLayer_Product_Item_Price) bool) (datatypes.SoftLayer_Product_Item_Price, error) {
+	for _, itemPrice := range itemPrices {
+		if itemPrice.LocationGroupId != 0 || !hasItemPriceCategory(itemPrice, categoryCode) {
+			continue
+		}
+
+		if match(itemPrice) {
+			return itemPrice, nil
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find item price for category '%s'", categoryCode))
+}
+
+func anyStorageItemPrice(itemPrice datatypes.SoftLayer_Product_Item_Price) bool {
+	return true
+}
+
+func hasItemPriceCategory(itemPrice datatypes.SoftLayer_Product_Item_Price, categoryCode string) bool {
+	for _, category := range itemPrice.Categories {
+		if category.CategoryCode == categoryCode {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isWithinCapacityRestriction(itemPrice datatypes.SoftLayer_Product_Item_Price, restrictionType string, value int) bool {
+	if itemPrice.CapacityRestrictionType != restrictionType {
+		return false
+	}
+
+	minimum, maximum, ok := parseCapacityRange(itemPrice.CapacityRestrictionMinimum, itemPrice.CapacityRestrictionMaximum)
+
+	return ok && minimum <= value && value <= maximum
+}
+
+func parseCapacityRange(minimumCapacity string, maximumCapacity string) (int, int, bool) {
+	minimum, err := strconv.Atoi(minimumCapacity)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	maximum, err := strconv.Atoi(maximumCapacity)
+	if err != nil {
+		return 0, 0, false
+	}
+
+	return minimum, maximum, true
+}
+
+func storageAsAServiceSpaceKeyName(tier float64) string {
+	return fmt.Sprintf("STORAGE_SPACE_FOR_%s_IOPS_PER_GB", strings.Replace(strconv.FormatFloat(tier, 'f', -1, 64), ".", "_", -1))
+}
+
+func getNetworkStorageOsFormatType(keyName string) (*datatypes.SoftLayer_Network_Storage_Iscsi_OS_Type_Template, error) {
+	if keyName == "" {
+		keyName = "LINUX"
+	}
+
+	for _, osFormatType := range networkStorageOsFormatTypes {
+		if strings.ToUpper(keyName) == osFormatType {
+			return &datatypes.SoftLayer_Network_Storage_Iscsi_OS_Type_Template{KeyName: osFormatType}, nil
+		}
+	}
+
+	return nil, errors.New(fmt.Sprintf("softlayer-go: unsupported OS format type '%s', expected one of %s", keyName, strings.Join(networkStorageOsFormatTypes, ", ")))
+}
+
+func (slns *softLayer_Network_Storage_Service) callStorageBoolMethod(volumeId int, method string, parameters datatypes.SoftLayer_Network_Storage_InitParameters, action string) (bool, error) {
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slns.GetName(), volumeId, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#%s, HTTP error code: '%d'", method, errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to %s, got '%s' as response from the API.", action, res))
+	}
+
+	return true, nil
+}
+
+func checkSnapshotScheduleOptions(options *softlayer.SnapshotScheduleOptions) error {
+	if options == nil {
+		return errors.New("softlayer-go: snapshot schedule options are required")
+	}
+
+	if options.RetentionCount <= 0 {
+		return errors.New(fmt.Sprintf("softlayer-go: snapshot retention count must be positive, got '%d'", options.RetentionCount))
+	}
+
+	if options.Minute < 0 || options.Minute > 59 {
+		return errors.New(fmt.Sprintf("softlayer-go: snapshot schedule minute must be between 0 and 59, got '%d'", options.Minute))
+	}
+
+	scheduleType := strings.ToUpper(options.ScheduleType)
+	switch scheduleType {
+	case SNAPSHOT_SCHEDULE_HOURLY:
+		return nil
+	case SNAPSHOT_SCHEDULE_DAILY, SNAPSHOT_SCHEDULE_WEEKLY:
+		if options.Hour < 0 || options.Hour > 23 {
+			return errors.New(fmt.Sprintf("softlayer-go: snapshot schedule hour must be between 0 and 23, got '%d'", options.Hour))
+		}
+	default:
+		return errors.New(fmt.Sprintf("softlayer-go: unsupported snapshot schedule type '%s', expected '%s', '%s' or '%s'", options.ScheduleType, SNAPSHOT_SCHEDULE_HOURLY, SNAPSHOT_SCHEDULE_DAILY, SNAPSHOT_SCHEDULE_WEEKLY))
+	}
+
+	if scheduleType == SNAPSHOT_SCHEDULE_WEEKLY {
+		for _, dayOfWeek := range snapshotScheduleDaysOfWeek {
+			if strings.ToUpper(options.DayOfWeek) == dayOfWeek {
+				return nil
+			}
+		}
+
+		return errors.New(fmt.Sprintf("softlayer-go: weekly snapshot schedules require a day of the week, got '%s'", options.DayOfWeek))
+	}
+
+	return nil
+}
+
+func checkReplicaOrderOptions(options *softlayer.ReplicaOrderOptions) error {
+	if options == nil {
+		return errors.New("softlayer-go: replica order options are required")
+	}
+
+	if options.Location == "" {
+		return errors.New("softlayer-go: replica location is required")
+	}
+
+	switch strings.ToUpper(options.ScheduleType) {
+	case SNAPSHOT_SCHEDULE_HOURLY, SNAPSHOT_SCHEDULE_DAILY, SNAPSHOT_SCHEDULE_WEEKLY:
+		return nil
+	}
+
+	return errors.New(fmt.Sprintf("softlayer-go: unsupported replication schedule type '%s', expected '%s', '%s' or '%s'", options.ScheduleType, SNAPSHOT_SCHEDULE_HOURLY, SNAPSHOT_SCHEDULE_DAILY, SNAPSHOT_SCHEDULE_WEEKLY))
+}
+
+func enduranceStorageTierOf(keyName string) (float64, bool) {
+	for tier, enduranceTier := range enduranceStorageTiers {
+		if enduranceTier.keyName == keyName {
+			return tier, true
+		}
+	}
+
+	return 0, false
+}
+
+func (slns *softLayer_Network_Storage_Service) getOrderOriginVolume(volumeId int) (datatypes.SoftLayer_Network_Storage, error) {
+	objectMask := []string{
+		"id",
+		"capacityGb",
+		"storageTierLevel",
+		"provisionedIops",
+		"snapshotCapacityGb",
+		"storageType.keyName",
+		"osType.keyName",
+		"schedules.id",
+		"schedules.type.keyname",
+		"serviceResource.datacenter.name",
+		"billingItem.categoryCode",
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+	}
+
+	volume := datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &volume)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return volume, nil
+}
+
+func (slns *softLayer_Network_Storage_Service) getReplicationStatus(volumeId int) (string, error) {
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slns.GetName(), volumeId), []string{"id", "replicationStatus"}, "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#getObject, HTTP error code: '%d'", errorCode)
+		return "", errors.New(errorMessage)
+	}
+
+	volume := datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &volume)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.ToUpper(volume.ReplicationStatus), nil
+}
+
+func (slns *softLayer_Network_Storage_Service) getReplicationVolumes(volumeId int, method string) ([]datatypes.SoftLayer_Network_Storage, error) {
+	objectMask := []string{
+		"id",
+		"username",
+		"capacityGb",
+		"serviceResourceBackendIpAddress",
+		"replicationStatus",
+	}
+
+	response, errorCode, err := slns.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/%s.json", slns.GetName(), volumeId, method), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Storage#%s, HTTP error code: '%d'", method, errorCode)
+		return []datatypes.SoftLayer_Network_Storage{}, errors.New(errorMessage)
+	}
+
+	volumes := []datatypes.SoftLayer_Network_Storage{}
+	err = json.Unmarshal(response, &volumes)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Storage{}, err
+	}
+
+	return volumes, nil
+}
+
+func getStorageAsAServiceCategory(volume datatypes.SoftLayer_Network_Storage) (string, *datatypes.SoftLayer_Network_Storage_Iscsi_OS_Type_Template, error) {
+	storageTypeKeyName := ""
+	if volume.StorageType != nil {
+		storageTypeKeyName = volume.StorageType.KeyName
+	}
+
+	switch {
+	case strings.Contains(storageTypeKeyName, "BLOCK"):
+		osFormatTypeKeyName := ""
+		if volume.OsType != nil {
+			osFormatTypeKeyName = volume.OsType.KeyName
+		}
+
+		osFormatType, err := getNetworkStorageOsFormatType(osFormatTypeKeyName)
+		if err != nil {
+			return "", nil, err
+		}
+
+		return "storage_block", osFormatType, nil
+	case strings.Contains(storageTypeKeyName, "FILE"):
+		return "storage_file", nil, nil
+	}
+
+	return "", nil, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' has unsupported storage type '%s'", volume.Id, storageTypeKeyName))
+}
+
+func checkStorageAsAServiceVolume(volume datatypes.SoftLayer_Network_Storage) error {
+	categoryCode := ""
+	if volume.BillingItem != nil {
+		categoryCode = volume.BillingItem.CategoryCode
+	}
+
+	if categoryCode != "storage_as_a_service" {
+		return errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' is not a storage as a service volume, got billing category '%s', only volumes ordered from package %d are supported", volume.Id, categoryCode, NETWORK_STORAGE_AS_A_SERVICE_PACKAGE_ID))
+	}
+
+	return nil
+}
+
+func isReplicationTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) bool {
+	name := strings.ToUpper(transaction.TransactionStatus.Name)
+	return strings.Contains(name, "REPLICA") || strings.Contains(name, "FAILOVER") || strings.Contains(name, "FAILBACK")
+}
+
+func isPerformanceStorageVolume(volume datatypes.SoftLayer_Network_Storage) bool {
+	return volume.StorageType != nil && strings.HasPrefix(volume.StorageType.KeyName, STORAGE_TYPE_PERFORMANCE)
+}
+
+func storageAsAServiceVolumeCategories(volume datatypes.SoftLayer_Network_Storage) []string {
+	if isPerformanceStorageVolume(volume) {
+		return []string{"performance_storage_space", "performance_storage_iops"}
+	}
+
+	return []string{"storage_tier_level", "performance_storage_space"}
+}
+
+func findVolumeStorageAsAServiceItemPrices(itemPrices []datatypes.SoftLayer_Product_Item_Price, volume datatypes.SoftLayer_Network_Storage, size int, tier float64, iops int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	if isPerformanceStorageVolume(volume) {
+		return findPerformanceStorageAsAServiceItemPrices(itemPrices, size, iops)
+	}
+
+	return findEnduranceStorageAsAServiceItemPrices(itemPrices, tier, size)
+}
+
+// Defaults size, tier and IOPS left at 0 to the ones of the volume
+func resolveVolumeCapacity(volume datatypes.SoftLayer_Network_Storage, size int, tier float64, iops int) (int, float64, int, error) {
+	if size == 0 {
+		size = volume.CapacityGb
+	}
+
+	if isPerformanceStorageVolume(volume) {
+		if tier != 0 {
+			return 0, 0, 0, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' is a performance volume, tiers only apply to endurance volumes", volume.Id))
+		}
+
+		if iops == 0 {
+			provisionedIops, err := strconv.Atoi(volume.ProvisionedIops)
+			if err != nil {
+				return 0, 0, 0, errors.New(fmt.Sprintf("softlayer-go: could not get the provisioned IOPS of volume with id '%d', got '%s'", volume.Id, volume.ProvisionedIops))
+			}
+			iops = provisionedIops
+		}
+
+		return size, 0, iops, nil
+	}
+
+	if iops != 0 {
+		return 0, 0, 0, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' is an endurance volume, IOPS only apply to performance volumes", volume.Id))
+	}
+
+	if tier == 0 {
+		currentTier, ok := enduranceStorageTierOf(volume.StorageTierLevel)
+		if !ok {
+			return 0, 0, 0, errors.New(fmt.Sprintf("softlayer-go: volume with id '%d' is not an endurance volume, got storage tier level '%s'", volume.Id, volume.StorageTierLevel))
+		}
+		tier = currentTier
+	}
+
+	if _, ok := enduranceStorageTiers[tier]; !ok {
+		return 0, 0, 0, errors.New(fmt.Sprintf("softlayer-go: unsupported endurance tier '%v', expected 0.25, 2, 4 or 10 IOPS per GB", tier))
+	}
+
+	return size, tier, 0, nil
 }
@@ -60,6 +60,12 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 			Expect(err).To(HaveOccurred())
 		})
 
+		It("fails with error for unsupported OS format types", func() {
+			volume, err = networkStorageService.CreateNetworkStorageWithOsFormatType(20, 1000, "fake-location", true, "SOLARIS")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(BeEmpty())
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -140,6 +146,12 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 				volume, err = networkStorageService.CreateNetworkStorage(20, 1000, "fake-location", true)
 				Expect(err).ToNot(HaveOccurred())
 			})
+
+			It("orders an iSCSI volume with the OS format type", func() {
+				volume, err = networkStorageService.CreateNetworkStorageWithOsFormatType(20, 1000, "fake-location", true, "vmware")
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"osFormatType":{"keyName":"VMWARE"}`))
+			})
 		})
 
 		Context("when SL API endpoint is unstable, timeout after several times of retries", func() {
@@ -267,6 +279,92 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 		})
 	})
 
+	Context("#HasAllowedSubnet", func() {
+		It("subnet allows to access volume", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getAllowedSubnets.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			allowed, err := networkStorageService.HasAllowedSubnet(123, 112233)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Network_Storage/123/getAllowedSubnets.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"allowedSubnets":{"id":{"operation":"112233"}}}`))
+		})
+
+		It("subnet is not allowed to access volume", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("[]")
+
+			allowed, err := networkStorageService.HasAllowedSubnet(123, 112233)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.HasAllowedSubnet(123, 112233)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.HasAllowedSubnet(123, 112233)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#HasAllowedIpAddress", func() {
+		It("IP address allows to access volume", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getAllowedIpAddresses.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			allowed, err := networkStorageService.HasAllowedIpAddress(123, 445566)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Network_Storage/123/getAllowedIpAddresses.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"allowedIpAddresses":{"id":{"operation":"445566"}}}`))
+		})
+
+		It("IP address is not allowed to access volume", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("[]")
+
+			allowed, err := networkStorageService.HasAllowedIpAddress(123, 445566)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(allowed).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.HasAllowedIpAddress(123, 445566)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.HasAllowedIpAddress(123, 445566)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#AttachNetworkStorageToVirtualGuest", func() {
 		var virtualGuest datatypes.SoftLayer_Virtual_Guest
 
@@ -473,6 +571,1457 @@ var _ = Describe("SoftLayer_Network_Storage", func() {
 		})
 	})
 
+	Context("#AttachNetworkStorageToSubnet", func() {
+		var subnet datatypes.SoftLayer_Network_Subnet
+
+		BeforeEach(func() {
+			subnet = datatypes.SoftLayer_Network_Subnet{
+				Id:                112233,
+				NetworkIdentifier: "10.0.0.0",
+				Cidr:              26,
+			}
+		})
+
+		It("Allow access to storage from subnet", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			resp, err := networkStorageService.AttachNetworkStorageToSubnet(subnet, 123)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(true))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/123/allowAccessFromSubnet.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("PUT"))
+		})
+
+		It("fails when the response is not a boolean", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"not allowed"}`)
+
+			_, err := networkStorageService.AttachNetworkStorageToSubnet(subnet, 123)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.AttachNetworkStorageToSubnet(subnet, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.AttachNetworkStorageToSubnet(subnet, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DetachNetworkStorageFromSubnet", func() {
+		var subnet datatypes.SoftLayer_Network_Subnet
+
+		BeforeEach(func() {
+			subnet = datatypes.SoftLayer_Network_Subnet{
+				Id:                112233,
+				NetworkIdentifier: "10.0.0.0",
+				Cidr:              26,
+			}
+		})
+
+		It("Revoke access to storage from subnet", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			err = networkStorageService.DetachNetworkStorageFromSubnet(subnet, 123)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/123/removeAccessFromSubnet.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					err = networkStorageService.DetachNetworkStorageFromSubnet(subnet, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					err = networkStorageService.DetachNetworkStorageFromSubnet(subnet, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#AttachNetworkStorageToIpAddress", func() {
+		var ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress
+
+		BeforeEach(func() {
+			ipAddress = datatypes.SoftLayer_Network_Subnet_IpAddress{
+				Id:        445566,
+				IpAddress: "10.0.0.12",
+			}
+		})
+
+		It("Allow access to storage from IP address", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			resp, err := networkStorageService.AttachNetworkStorageToIpAddress(ipAddress, 123)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(resp).To(Equal(true))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/123/allowAccessFromIpAddress.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("PUT"))
+		})
+
+		It("fails when the response is not a boolean", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"not allowed"}`)
+
+			_, err := networkStorageService.AttachNetworkStorageToIpAddress(ipAddress, 123)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.AttachNetworkStorageToIpAddress(ipAddress, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.AttachNetworkStorageToIpAddress(ipAddress, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DetachNetworkStorageFromIpAddress", func() {
+		var ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress
+
+		BeforeEach(func() {
+			ipAddress = datatypes.SoftLayer_Network_Subnet_IpAddress{
+				Id:        445566,
+				IpAddress: "10.0.0.12",
+			}
+		})
+
+		It("Revoke access to storage from IP address", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			err = networkStorageService.DetachNetworkStorageFromIpAddress(ipAddress, 123)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/123/removeAccessFromIpAddress.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					err = networkStorageService.DetachNetworkStorageFromIpAddress(ipAddress, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					err = networkStorageService.DetachNetworkStorageFromIpAddress(ipAddress, 123)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#OrderFileStorage", func() {
+		Context("when ordering endurance file storage", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Product_Package_getItemPrices_storage_as_a_service.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders the tier from the storage as a service package", func() {
+				receipt, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        500,
+					StorageType: "ENDURANCE",
+					Tier:        2,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/759/getItemPrices.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_file","storage_tier_level","performance_storage_space"]}]}}}}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal10","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189453,"locationGroupId":0},{"id":193373,"locationGroupId":0},{"id":193433,"locationGroupId":0}],"quantity":1,"volumeSize":500}]}`))
+			})
+
+			It("fails when no storage space price matches the tier", func() {
+				_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        500,
+					StorageType: "ENDURANCE",
+					Tier:        4,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+
+			It("fails for unsupported tiers", func() {
+				_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        100,
+					StorageType: "endurance",
+					Tier:        3,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+			})
+		})
+
+		Context("when ordering performance file storage", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Product_Package_getItemPrices_performance_storage_nfs.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders the item prices of the size and IOPS", func() {
+				receipt, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:         "dal10",
+					Size:             100,
+					StorageType:      "performance",
+					Iops:             1000,
+					UseHourlyPricing: true,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/222/getItemPrices.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs","location":"dal10","packageId":222,"prices":[{"id":40672,"locationGroupId":0},{"id":40742,"locationGroupId":0},{"id":41572,"locationGroupId":0}],"quantity":1,"useHourlyPricing":true}]}`))
+			})
+
+			It("fails when no IOPS price matches", func() {
+				_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        100,
+					StorageType: "PERFORMANCE",
+					Iops:        2000,
+				})
+				Expect(err).To(HaveOccurred())
+			})
+
+			It("fails when IOPS are missing", func() {
+				_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        100,
+					StorageType: "PERFORMANCE",
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+			})
+		})
+
+		It("fails without a location or size", func() {
+			_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{Size: 100, StorageType: "ENDURANCE", Tier: 2})
+			Expect(err).To(HaveOccurred())
+
+			_, err = networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{Location: "dal10", StorageType: "ENDURANCE", Tier: 2})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails for unsupported storage types", func() {
+			_, err := networkStorageService.OrderFileStorage(&softlayer.NetworkStorageOrderOptions{Location: "dal10", Size: 100, StorageType: "CONSISTENT"})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#OrderBlockStorage", func() {
+		Context("when ordering endurance block storage", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Product_Package_getItemPrices_storage_as_a_service.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders the tier from the storage as a service package with the OS format type", func() {
+				receipt, err := networkStorageService.OrderBlockStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:     "dal10",
+					Size:         500,
+					StorageType:  "ENDURANCE",
+					Tier:         2,
+					OsFormatType: "vmware",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/759/getItemPrices.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_block","storage_tier_level","performance_storage_space"]}]}}}}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal10","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189443,"locationGroupId":0},{"id":193373,"locationGroupId":0},{"id":193433,"locationGroupId":0}],"quantity":1,"volumeSize":500,"osFormatType":{"keyName":"VMWARE"}}]}`))
+			})
+
+			It("defaults to the LINUX OS format type", func() {
+				_, err := networkStorageService.OrderBlockStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        1000,
+					StorageType: "ENDURANCE",
+					Tier:        10,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"id":193503,"locationGroupId":0}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"osFormatType":{"keyName":"LINUX"}`))
+			})
+
+			It("fails when the size is out of the range of the tier", func() {
+				_, err := networkStorageService.OrderBlockStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:    "dal10",
+					Size:        8000,
+					StorageType: "ENDURANCE",
+					Tier:        10,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+
+			It("fails for unsupported OS format types", func() {
+				_, err := networkStorageService.OrderBlockStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:     "dal10",
+					Size:         500,
+					StorageType:  "ENDURANCE",
+					Tier:         2,
+					OsFormatType: "SOLARIS",
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+			})
+		})
+
+		Context("when ordering performance block storage", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Product_Package_getItemPrices_performance_storage_iscsi.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders an iSCSI volume with the OS format type", func() {
+				receipt, err := networkStorageService.OrderBlockStorage(&softlayer.NetworkStorageOrderOptions{
+					Location:     "dal10",
+					Size:         100,
+					StorageType:  "PERFORMANCE",
+					Iops:         1000,
+					OsFormatType: "HYPER_V",
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/222/getItemPrices.json"))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi","location":"dal10","packageId":222,"prices":[{"id":40552,"locationGroupId":0},{"id":40742,"locationGroupId":0},{"id":41572,"locationGroupId":0}],"quantity":1,"osFormatType":{"keyName":"HYPER_V"}}]}`))
+			})
+		})
+	})
+
+	Context("#GetNetworkStorageOfferings", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fileNames := []string{
+				"SoftLayer_Product_Package_getRegions.json",
+				"SoftLayer_Product_Package_getItemPrices_storage_as_a_service.json",
+				"SoftLayer_Product_Package_getRegions.json",
+				"SoftLayer_Product_Package_getItemPrices_performance_storage_nfs.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("lists the endurance tiers and performance IOPS offered in the datacenter", func() {
+			offerings, err := networkStorageService.GetNetworkStorageOfferings("dal10")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(offerings).To(Equal([]softlayer.NetworkStorageOffering{
+				softlayer.NetworkStorageOffering{StorageType: "ENDURANCE", MinimumSize: 20, MaximumSize: 12000, Tier: 0.25},
+				softlayer.NetworkStorageOffering{StorageType: "ENDURANCE", MinimumSize: 20, MaximumSize: 12000, Tier: 2},
+				softlayer.NetworkStorageOffering{StorageType: "ENDURANCE", MinimumSize: 20, MaximumSize: 4000, Tier: 10},
+				softlayer.NetworkStorageOffering{StorageType: "PERFORMANCE", MinimumSize: 100, MaximumSize: 100, Iops: 1000},
+				softlayer.NetworkStorageOffering{StorageType: "PERFORMANCE", MinimumSize: 250, MaximumSize: 250, Iops: 1000},
+			}))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(4))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["performance_storage_space","performance_storage_iops"]}]}}}}`))
+		})
+
+		It("only lists the prices of the location group of the datacenter", func() {
+			offerings, err := networkStorageService.GetNetworkStorageOfferings("ams03")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(offerings).To(ContainElement(softlayer.NetworkStorageOffering{StorageType: "ENDURANCE", MinimumSize: 20, MaximumSize: 12000, Tier: 4}))
+			Expect(offerings).ToNot(ContainElement(softlayer.NetworkStorageOffering{StorageType: "ENDURANCE", MinimumSize: 20, MaximumSize: 12000, Tier: 0.25}))
+		})
+
+		It("fails when no storage is offered in the datacenter", func() {
+			_, err := networkStorageService.GetNetworkStorageOfferings("sjc01")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+
+		It("fails without a datacenter", func() {
+			_, err := networkStorageService.GetNetworkStorageOfferings("")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+
+	Context("#GetFileNetworkMountAddress", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getObject_file.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the mount address of the file volume", func() {
+			mountAddress, err := networkStorageService.GetFileNetworkMountAddress(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(mountAddress).To(Equal("fsf-dal1001a-fz.adn.networklayer.com:/SL01SV123456_1/data01"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getObject.json"))
+		})
+
+		It("fails for block volumes", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"id":1234567,"nasType":"ISCSI"}`)
+
+			_, err := networkStorageService.GetFileNetworkMountAddress(1234567)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the volume has no mount address yet", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"id":1234567,"nasType":"NAS"}`)
+
+			_, err := networkStorageService.GetFileNetworkMountAddress(1234567)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetFileNetworkMountAddress(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetFileNetworkMountAddress(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#OrderSnapshotSpace", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fileNames := []string{
+				"SoftLayer_Network_Storage_Service_getObject_endurance.json",
+				"SoftLayer_Product_Package_getItemPrices_snapshot_space.json",
+				"SoftLayer_Product_Order_placeOrder_network_storage.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("orders the snapshot space price matching the tier of the volume", func() {
+			receipt, err := networkStorageService.OrderSnapshotSpace(1234567, 20)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(9876543))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/759/getItemPrices.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace","packageId":759,"prices":[{"id":193863,"locationGroupId":0}],"quantity":1,"volumeId":1234567}]}`))
+		})
+
+		It("orders the snapshot space price matching the IOPS of performance volumes", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fileNames := []string{
+				"SoftLayer_Network_Storage_Service_getObject_performance_file.json",
+				"SoftLayer_Product_Package_getItemPrices_snapshot_space.json",
+				"SoftLayer_Product_Order_placeOrder_network_storage.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			_, err := networkStorageService.OrderSnapshotSpace(7654321, 20)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace","packageId":759,"prices":[{"id":193903,"locationGroupId":0}],"quantity":1,"volumeId":7654321}]}`))
+		})
+
+		It("fails when no snapshot space price matches the size", func() {
+			_, err := networkStorageService.OrderSnapshotSpace(1234567, 1000)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+
+		It("fails for volumes with neither a storage tier nor IOPS", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":1234567,"billingItem":{"id":23456789,"categoryCode":"storage_as_a_service"}}`)}
+
+			_, err := networkStorageService.OrderSnapshotSpace(1234567, 20)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails for volumes not ordered from the storage as a service package", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":1234567,"storageTierLevel":"READHEAVY_TIER","billingItem":{"id":23456789,"categoryCode":"storage_service_enterprise"}}`)}
+
+			_, err := networkStorageService.OrderSnapshotSpace(1234567, 20)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("storage_service_enterprise"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails for sizes that are not positive", func() {
+			_, err := networkStorageService.OrderSnapshotSpace(1234567, 0)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+
+	Context("#CreateSnapshot", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_createSnapshot.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("creates a snapshot of the volume with the notes", func() {
+			snapshot, err := networkStorageService.CreateSnapshot(1234567, "before upgrade")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(snapshot.Id).To(Equal(7654321))
+			Expect(snapshot.Notes).To(Equal("before upgrade"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/createSnapshot.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("POST"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["before upgrade"]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.CreateSnapshot(1234567, "before upgrade")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.CreateSnapshot(1234567, "before upgrade")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetSnapshots", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getSnapshots.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the snapshots of the volume", func() {
+			snapshots, err := networkStorageService.GetSnapshots(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(snapshots)).To(Equal(2))
+			Expect(snapshots[0].Id).To(Equal(7654321))
+			Expect(snapshots[0].Notes).To(Equal("before upgrade"))
+			Expect(snapshots[1].SnapshotSizeBytes).To(Equal("1048576"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getSnapshots.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetSnapshots(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetSnapshots(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DeleteSnapshot", func() {
+		It("deletes the snapshot", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			deleted, err := networkStorageService.DeleteSnapshot(7654321)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(deleted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/7654321.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestType).To(Equal("DELETE"))
+		})
+
+		It("fails to delete the snapshot", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			deleted, err := networkStorageService.DeleteSnapshot(7654321)
+			Expect(err).To(HaveOccurred())
+			Expect(deleted).To(BeFalse())
+		})
+
+		It("reports the HTTP error code instead of the response body", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 404
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Unable to find object with id of '7654321'."}`)
+
+			_, err := networkStorageService.DeleteSnapshot(7654321)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("HTTP error code: '404'"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.DeleteSnapshot(7654321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.DeleteSnapshot(7654321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#RestoreFromSnapshot", func() {
+		It("restores the volume from the snapshot", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			restored, err := networkStorageService.RestoreFromSnapshot(1234567, 7654321)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(restored).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/restoreFromSnapshot.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[7654321]}`))
+		})
+
+		It("fails when the API does not restore the volume", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			restored, err := networkStorageService.RestoreFromSnapshot(1234567, 7654321)
+			Expect(err).To(HaveOccurred())
+			Expect(restored).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.RestoreFromSnapshot(1234567, 7654321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.RestoreFromSnapshot(1234567, 7654321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EnableSnapshots", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+		})
+
+		It("enables an hourly schedule", func() {
+			enabled, err := networkStorageService.EnableSnapshots(1234567, &softlayer.SnapshotScheduleOptions{ScheduleType: "hourly", RetentionCount: 24, Minute: 15})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(enabled).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/enableSnapshots.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["HOURLY",24,15,0,""]}`))
+		})
+
+		It("enables a weekly schedule on the given day", func() {
+			_, err := networkStorageService.EnableSnapshots(1234567, &softlayer.SnapshotScheduleOptions{ScheduleType: "WEEKLY", RetentionCount: 4, Minute: 0, Hour: 3, DayOfWeek: "sunday"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["WEEKLY",4,0,3,"SUNDAY"]}`))
+		})
+
+		It("fails for invalid schedules", func() {
+			invalidOptions := []*softlayer.SnapshotScheduleOptions{
+				nil,
+				&softlayer.SnapshotScheduleOptions{ScheduleType: "MONTHLY", RetentionCount: 1},
+				&softlayer.SnapshotScheduleOptions{ScheduleType: "DAILY", RetentionCount: 0},
+				&softlayer.SnapshotScheduleOptions{ScheduleType: "HOURLY", RetentionCount: 1, Minute: 60},
+				&softlayer.SnapshotScheduleOptions{ScheduleType: "DAILY", RetentionCount: 1, Hour: 24},
+				&softlayer.SnapshotScheduleOptions{ScheduleType: "WEEKLY", RetentionCount: 1, DayOfWeek: "SOMEDAY"},
+			}
+			for _, options := range invalidOptions {
+				_, err := networkStorageService.EnableSnapshots(1234567, options)
+				Expect(err).To(HaveOccurred())
+			}
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.EnableSnapshots(1234567, &softlayer.SnapshotScheduleOptions{ScheduleType: "DAILY", RetentionCount: 7, Hour: 2})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.EnableSnapshots(1234567, &softlayer.SnapshotScheduleOptions{ScheduleType: "DAILY", RetentionCount: 7, Hour: 2})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#DisableSnapshots", func() {
+		It("disables the schedule", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			disabled, err := networkStorageService.DisableSnapshots(1234567, "daily")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(disabled).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/disableSnapshots.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["DAILY"]}`))
+		})
+
+		It("fails for unsupported schedule types", func() {
+			_, err := networkStorageService.DisableSnapshots(1234567, "MONTHLY")
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.DisableSnapshots(1234567, "DAILY")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.DisableSnapshots(1234567, "DAILY")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetSnapshotSchedules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getSchedules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the snapshot schedules of the volume", func() {
+			schedules, err := networkStorageService.GetSnapshotSchedules(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(schedules)).To(Equal(2))
+			Expect(schedules[0].Type.Keyname).To(Equal("SNAPSHOT_DAILY"))
+			Expect(schedules[0].RetentionCount).To(Equal("7"))
+			Expect(schedules[1].DayOfWeek).To(Equal("SUNDAY"))
+			Expect(schedules[1].Active).To(Equal(0))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getSchedules.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetSnapshotSchedules(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetSnapshotSchedules(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#OrderReplicaVolume", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fileNames := []string{
+				"SoftLayer_Network_Storage_Service_getObject_replication_source.json",
+				"SoftLayer_Product_Package_getItemPrices_replication.json",
+				"SoftLayer_Product_Order_placeOrder_network_storage.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("orders a replica of the volume following its snapshot schedule", func() {
+			receipt, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "daily",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(9876543))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskPath).To(Equal("SoftLayer_Product_Package/759/getItemPrices.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_block","storage_tier_level","performance_storage_space","storage_snapshot_space","performance_storage_replication"]}]}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal12","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189443,"locationGroupId":0},{"id":193373,"locationGroupId":0},{"id":193433,"locationGroupId":0},{"id":193863,"locationGroupId":0},{"id":193933,"locationGroupId":0}],"quantity":1,"volumeSize":500,"osFormatType":{"keyName":"VMWARE"},"originVolumeId":1234567,"originVolumeScheduleId":112234}]}`))
+		})
+
+		It("orders the IOPS prices of performance volumes", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fileNames := []string{
+				"SoftLayer_Network_Storage_Service_getObject_performance_file.json",
+				"SoftLayer_Product_Package_getItemPrices_performance_storage_as_a_service.json",
+				"SoftLayer_Product_Order_placeOrder_network_storage.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			_, err := networkStorageService.OrderReplicaVolume(7654321, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "HOURLY",
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_file","performance_storage_space","performance_storage_iops","storage_snapshot_space","performance_storage_replication"]}]}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal12","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189453,"locationGroupId":0},{"id":190173,"locationGroupId":0},{"id":190053,"locationGroupId":0},{"id":191193,"locationGroupId":0},{"id":191203,"locationGroupId":0}],"quantity":1,"volumeSize":100,"iops":1000,"originVolumeId":7654321,"originVolumeScheduleId":223344}]}`))
+		})
+
+		It("fails when the volume has no schedule of the schedule type", func() {
+			_, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "WEEKLY",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails when the volume has no snapshot space", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":1234567,"capacityGb":500,"storageTierLevel":"READHEAVY_TIER","snapshotCapacityGb":"0","billingItem":{"id":23456789,"categoryCode":"storage_as_a_service"}}`)}
+
+			_, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "DAILY",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails for volumes not ordered from the storage as a service package", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":1234567,"capacityGb":500,"storageTierLevel":"READHEAVY_TIER","snapshotCapacityGb":"20","billingItem":{"id":23456789,"categoryCode":"storage_service_enterprise"}}`)}
+
+			_, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "DAILY",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("storage_service_enterprise"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+
+		It("fails for unsupported schedule types", func() {
+			_, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				Location:     "dal12",
+				ScheduleType: "MONTHLY",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("fails without a location", func() {
+			_, err := networkStorageService.OrderReplicaVolume(1234567, &softlayer.ReplicaOrderOptions{
+				ScheduleType: "DAILY",
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+
+	Context("#GetReplicantVolumes", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getReplicants.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the replicant volumes of the volume", func() {
+			replicants, err := networkStorageService.GetReplicantVolumes(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(replicants)).To(Equal(1))
+			Expect(replicants[0].Id).To(Equal(2345678))
+			Expect(replicants[0].ReplicationStatus).To(Equal("REPLICATION_PROVISIONING_SUCCESS"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getReplicants.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetReplicantVolumes(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetReplicantVolumes(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetReplicationPartners", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getReplicationPartners.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the replication partners of the volume", func() {
+			partners, err := networkStorageService.GetReplicationPartners(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(partners)).To(Equal(2))
+			Expect(partners[1].Username).To(Equal("SL01SEL123456_1_REP_2"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getReplicationPartners.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetReplicationPartners(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetReplicationPartners(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#FailoverToReplicant", func() {
+		It("fails the volume over to the replicant", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			failedOver, err := networkStorageService.FailoverToReplicant(1234567, 2345678, true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failedOver).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/failoverToReplicant.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[2345678,true]}`))
+		})
+
+		It("fails when the API does not fail the volume over", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			failedOver, err := networkStorageService.FailoverToReplicant(1234567, 2345678, false)
+			Expect(err).To(HaveOccurred())
+			Expect(failedOver).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.FailoverToReplicant(1234567, 2345678, false)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.FailoverToReplicant(1234567, 2345678, false)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#FailbackFromReplicant", func() {
+		It("fails the volume back from the replicant", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			failedBack, err := networkStorageService.FailbackFromReplicant(1234567, 2345678)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(failedBack).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Storage/1234567/failbackFromReplicant.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[2345678]}`))
+		})
+
+		It("fails when the API does not fail the volume back", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			failedBack, err := networkStorageService.FailbackFromReplicant(1234567, 2345678)
+			Expect(err).To(HaveOccurred())
+			Expect(failedBack).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.FailbackFromReplicant(1234567, 2345678)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkStorageService.FailbackFromReplicant(1234567, 2345678)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetActiveTransactions", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getActiveTransactions.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the active transactions of the volume", func() {
+			activeTransactions, err := networkStorageService.GetActiveTransactions(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(activeTransactions)).To(Equal(1))
+			Expect(activeTransactions[0].TransactionStatus.Name).To(Equal("VOLUME_REPLICATION_SETUP"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getActiveTransactions.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("transactionStatus.name"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetActiveTransactions(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkStorageService.GetActiveTransactions(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#WaitForReplication", func() {
+		BeforeEach(func() {
+			os.Setenv("SL_STORAGE_REPLICATION_TIMEOUT", "3")
+			os.Setenv("SL_STORAGE_REPLICATION_POLLING_INTERVAL", "1")
+		})
+
+		AfterEach(func() {
+			os.Setenv("SL_STORAGE_REPLICATION_TIMEOUT", "")
+			os.Setenv("SL_STORAGE_REPLICATION_POLLING_INTERVAL", "")
+		})
+
+		It("waits until the replication transactions are done before reading the replication status", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Network_Storage_Service_getActiveTransactions.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses,
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_SUCCESS"}`),
+			)
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(3))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Storage/1234567/getObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("replicationStatus"))
+		})
+
+		It("waits until the replication status reaches a terminal state", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_IN_PROGRESS"}`),
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_SUCCESS"}`),
+			}
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(4))
+		})
+
+		It("ignores active transactions that are not about replication", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte(`[{"id":55443323,"transactionStatus":{"name":"SNAPSHOT_CREATE"}}]`),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_SUCCESS"}`),
+			}
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+
+		It("completes once a failover has completed", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"FAILOVER_COMPLETED"}`),
+			}
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("stops waiting when the replication failed", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_FAILED"}`),
+				[]byte("[]"),
+				[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_SUCCESS"}`),
+			}
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+
+		It("fails when the replication never reaches a terminal state", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			for i := 0; i < 5; i++ {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses,
+					[]byte("[]"),
+					[]byte(`{"id":1234567,"replicationStatus":"REPLICATION_PROVISIONING_IN_PROGRESS"}`),
+				)
+			}
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails when the replication transactions never finish", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Storage_Service_getActiveTransactions.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = networkStorageService.WaitForReplication(1234567)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#OrderModifiedVolume", func() {
+		Context("when modifying an endurance volume", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Network_Storage_Service_getObject_endurance_block.json",
+					"SoftLayer_Product_Package_getItemPrices_storage_as_a_service.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders an upgrade to the new size and tier", func() {
+				receipt, err := networkStorageService.OrderModifiedVolume(1234567, &softlayer.NetworkStorageModifyOptions{
+					Size: 1000,
+					Tier: 10,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_tier_level","performance_storage_space"]}]}}}}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":194763,"locationGroupId":0},{"id":193503,"locationGroupId":0}],"volumeSize":1000,"volume":{"id":1234567}}]}`))
+			})
+
+			It("fails when the volume would shrink", func() {
+				_, err := networkStorageService.OrderModifiedVolume(1234567, &softlayer.NetworkStorageModifyOptions{
+					Size: 100,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+
+			It("fails when nothing is modified", func() {
+				_, err := networkStorageService.OrderModifiedVolume(1234567, &softlayer.NetworkStorageModifyOptions{
+					Tier: 2,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+
+			It("fails when modifying the IOPS", func() {
+				_, err := networkStorageService.OrderModifiedVolume(1234567, &softlayer.NetworkStorageModifyOptions{
+					Iops: 2000,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+		})
+
+		Context("when modifying a performance volume", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Network_Storage_Service_getObject_performance_file.json",
+					"SoftLayer_Product_Package_getItemPrices_performance_storage_as_a_service.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders an upgrade to the new size and IOPS", func() {
+				receipt, err := networkStorageService.OrderModifiedVolume(7654321, &softlayer.NetworkStorageModifyOptions{
+					Size: 500,
+					Iops: 2000,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","performance_storage_space","performance_storage_iops"]}]}}}}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":190233,"locationGroupId":0},{"id":190063,"locationGroupId":0}],"volumeSize":500,"iops":2000,"volume":{"id":7654321}}]}`))
+			})
+
+			It("keeps the current size when only the IOPS change", func() {
+				_, err := networkStorageService.OrderModifiedVolume(7654321, &softlayer.NetworkStorageModifyOptions{
+					Iops: 4000,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"prices":[{"id":189433,"locationGroupId":0},{"id":190173,"locationGroupId":0},{"id":190053,"locationGroupId":0}],"volumeSize":100,"iops":4000`))
+			})
+
+			It("fails when modifying the tier", func() {
+				_, err := networkStorageService.OrderModifiedVolume(7654321, &softlayer.NetworkStorageModifyOptions{
+					Tier: 4,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+		})
+
+		It("fails for volumes not ordered from the storage as a service package", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":1234567,"capacityGb":500,"storageTierLevel":"READHEAVY_TIER","storageType":{"keyName":"ENDURANCE_BLOCK_STORAGE"},"billingItem":{"id":23456789,"categoryCode":"storage_service_enterprise"}}`)}
+
+			_, err := networkStorageService.OrderModifiedVolume(1234567, &softlayer.NetworkStorageModifyOptions{
+				Size: 1000,
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("storage_service_enterprise"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("billingItem.categoryCode"))
+		})
+
+		It("fails without options", func() {
+			_, err := networkStorageService.OrderModifiedVolume(1234567, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+
+	Context("#OrderDuplicateVolume", func() {
+		Context("when duplicating an endurance volume", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Network_Storage_Service_getObject_endurance_block.json",
+					"SoftLayer_Product_Package_getItemPrices_replication.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders a duplicate of the snapshot in the datacenter of the volume", func() {
+				receipt, err := networkStorageService.OrderDuplicateVolume(1234567, &softlayer.NetworkStorageDuplicateOptions{
+					SnapshotId:   7654321,
+					SnapshotSize: 20,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"categories":{"categoryCode":{"operation":"in","options":[{"name":"data","value":["storage_as_a_service","storage_block","storage_tier_level","performance_storage_space","storage_snapshot_space"]}]}}}}`))
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal10","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189443,"locationGroupId":0},{"id":193373,"locationGroupId":0},{"id":193433,"locationGroupId":0},{"id":193863,"locationGroupId":0}],"quantity":1,"volumeSize":500,"osFormatType":{"keyName":"VMWARE"},"duplicateOriginVolumeId":1234567,"duplicateOriginSnapshotId":7654321}]}`))
+			})
+
+			It("fails when the duplicate is smaller than the volume", func() {
+				_, err := networkStorageService.OrderDuplicateVolume(1234567, &softlayer.NetworkStorageDuplicateOptions{
+					Size: 100,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+			})
+
+			It("fails for negative snapshot space sizes", func() {
+				_, err := networkStorageService.OrderDuplicateVolume(1234567, &softlayer.NetworkStorageDuplicateOptions{
+					SnapshotSize: -20,
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+			})
+		})
+
+		Context("when duplicating a performance volume", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Network_Storage_Service_getObject_performance_file.json",
+					"SoftLayer_Product_Package_getItemPrices_performance_storage_as_a_service.json",
+					"SoftLayer_Product_Order_placeOrder_network_storage.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("defaults to the size and IOPS of the volume", func() {
+				receipt, err := networkStorageService.OrderDuplicateVolume(7654321, nil)
+				Expect(err).ToNot(HaveOccurred())
+				Expect(receipt.OrderId).To(Equal(9876543))
+
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Storage_AsAService","location":"dal10","packageId":759,"prices":[{"id":189433,"locationGroupId":0},{"id":189453,"locationGroupId":0},{"id":190173,"locationGroupId":0},{"id":190053,"locationGroupId":0}],"quantity":1,"volumeSize":100,"iops":1000,"duplicateOriginVolumeId":7654321}]}`))
+			})
+
+			It("orders the snapshot space price matching the IOPS", func() {
+				_, err := networkStorageService.OrderDuplicateVolume(7654321, &softlayer.NetworkStorageDuplicateOptions{
+					SnapshotSize: 20,
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"id":190053,"locationGroupId":0},{"id":191193,"locationGroupId":0}]`))
+			})
+		})
+
+		It("fails for volumes not ordered from the storage as a service package", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte(`{"id":7654321,"capacityGb":100,"provisionedIops":"1000","storageType":{"keyName":"PERFORMANCE_BLOCK_STORAGE"},"billingItem":{"id":23456789,"categoryCode":"performance_storage_iscsi"}}`)}
+
+			_, err := networkStorageService.OrderDuplicateVolume(7654321, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("performance_storage_iscsi"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+	})
+
 	Context("#DeleteObject", func() {
 		BeforeEach(func() {
 			volume.Id = 1234567
@@ -0,0 +1,284 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	NETWORK_SUBNET_ORDER_COMPLEX_TYPE = "SoftLayer_Container_Product_Order_Network_Subnet"
+	NETWORK_SUBNET_PACKAGE_ID         = 0
+
+	SUBNET_TYPE_PORTABLE = "PORTABLE"
+	SUBNET_TYPE_STATIC   = "STATIC"
+)
+
+type softLayer_Network_Subnet_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Subnet_Service(client softlayer.Client) *softLayer_Network_Subnet_Service {
+	return &softLayer_Network_Subnet_Service{
+		client: client,
+	}
+}
+
+func (slnss *softLayer_Network_Subnet_Service) GetName() string {
+	return "SoftLayer_Network_Subnet"
+}
+
+func (slnss *softLayer_Network_Subnet_Service) GetObject(id int) (datatypes.SoftLayer_Network_Subnet, error) {
+	objectMask := []string{
+		"id",
+		"networkIdentifier",
+		"cidr",
+		"netmask",
+		"gateway",
+		"broadcastAddress",
+		"subnetType",
+		"networkVlanId",
+		"totalIpAddresses",
+		"usableIpAddressCount",
+		"version",
+		"note",
+		"datacenter",
+	}
+
+	response, errorCode, err := slnss.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnss.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Subnet{}, errors.New(errorMessage)
+	}
+
+	subnet := datatypes.SoftLayer_Network_Subnet{}
+	err = json.Unmarshal(response, &subnet)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet{}, err
+	}
+
+	return subnet, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) GetIpAddresses(id int) ([]datatypes.SoftLayer_Network_Subnet_IpAddress, error) {
+	objectMask := []string{
+		"id",
+		"ipAddress",
+		"subnetId",
+		"isBroadcast",
+		"isGateway",
+		"isNetwork",
+		"isReserved",
+		"note",
+		"virtualGuest.id",
+		"virtualGuest.hostname",
+		"virtualGuest.domain",
+		"hardware.id",
+		"hardware.hostname",
+		"hardware.domain",
+	}
+
+	response, errorCode, err := slnss.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getIpAddresses.json", slnss.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet#getIpAddresses, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress{}, errors.New(errorMessage)
+	}
+
+	ipAddresses := []datatypes.SoftLayer_Network_Subnet_IpAddress{}
+	err = json.Unmarshal(response, &ipAddresses)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	return ipAddresses, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) GetFreeIpAddresses(id int) ([]datatypes.SoftLayer_Network_Subnet_IpAddress, error) {
+	ipAddresses, err := slnss.GetIpAddresses(id)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	freeIpAddresses := []datatypes.SoftLayer_Network_Subnet_IpAddress{}
+	for _, ipAddress := range ipAddresses {
+		if ipAddress.IsNetwork || ipAddress.IsGateway || ipAddress.IsBroadcast || ipAddress.IsReserved {
+			continue
+		}
+
+		if ipAddress.VirtualGuest != nil || ipAddress.Hardware != nil {
+			continue
+		}
+
+		freeIpAddresses = append(freeIpAddresses, ipAddress)
+	}
+
+	return freeIpAddresses, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) GetReverseDomainRecords(id int) ([]datatypes.SoftLayer_Dns_Domain, error) {
+	objectMask := []string{
+		"id",
+		"name",
+		"serial",
+		"updateDate",
+		"resourceRecords",
+	}
+
+	response, errorCode, err := slnss.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getReverseDomainRecords.json", slnss.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet#getReverseDomainRecords, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Dns_Domain{}, errors.New(errorMessage)
+	}
+
+	reverseDomains := []datatypes.SoftLayer_Dns_Domain{}
+	err = json.Unmarshal(response, &reverseDomains)
+	if err != nil {
+		return []datatypes.SoftLayer_Dns_Domain{}, err
+	}
+
+	return reverseDomains, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) Route(id int, endPointType string, endPointIdentifier string) (bool, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Network_Subnet_InitParameters{Parameters: []interface{}{endPointType, endPointIdentifier}})
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/route.json", slnss.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet#route, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to route subnet with id '%d' to '%s', got '%s' as response from the API.", id, endPointIdentifier, res))
+	}
+
+	return true, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) ClearRoute(id int) (bool, error) {
+	response, errorCode, err := slnss.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/clearRoute.json", slnss.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet#clearRoute, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to clear route of subnet with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) OrderSubnet(options *softlayer.SubnetOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	err := slnss.checkSubnetOrderRequiredValues(options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	price, err := slnss.findSubnetItemPrice(options)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Subnet{
+		ComplexType: NETWORK_SUBNET_ORDER_COMPLEX_TYPE,
+		PackageId:   NETWORK_SUBNET_PACKAGE_ID,
+		Prices: []datatypes.SoftLayer_Product_Item_Price{
+			datatypes.SoftLayer_Product_Item_Price{Id: price.Id},
+		},
+		Quantity:            1,
+		EndPointVlanId:      options.VlanId,
+		EndPointIpAddressId: options.EndPointIpAddressId,
+	}
+
+	productOrderService, err := slnss.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkSubnet(order)
+}
+
+//Private methods
+
+func (slnss *softLayer_Network_Subnet_Service) checkSubnetOrderRequiredValues(options *softlayer.SubnetOrderOptions) error {
+	if options == nil {
+		return errors.New("softlayer-go: subnet order options are required")
+	}
+
+	if options.Capacity <= 0 {
+		return errors.New("softlayer-go: subnet capacity is required")
+	}
+
+	switch strings.ToUpper(options.Type) {
+	case SUBNET_TYPE_PORTABLE:
+		if options.VlanId == 0 {
+			return errors.New("softlayer-go: VLAN id is required to order a portable subnet")
+		}
+	case SUBNET_TYPE_STATIC:
+		if options.EndPointIpAddressId == 0 {
+			return errors.New("softlayer-go: end point IP address id is required to order a static subnet")
+		}
+	default:
+		return errors.New(fmt.Sprintf("softlayer-go: unsupported subnet type '%s', expected '%s' or '%s'", options.Type, SUBNET_TYPE_PORTABLE, SUBNET_TYPE_STATIC))
+	}
+
+	return nil
+}
+
+func (slnss *softLayer_Network_Subnet_Service) findSubnetItemPrice(options *softlayer.SubnetOrderOptions) (datatypes.SoftLayer_Product_Item_Price, error) {
+	networkSpace := "PRIVATE"
+	if options.Public {
+		networkSpace = "PUBLIC"
+	}
+
+	keyName := fmt.Sprintf("%d_%s_%s_IP_ADDRESSES", options.Capacity, strings.ToUpper(options.Type), networkSpace)
+
+	productPackageService, err := slnss.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"item":{"keyName":{"operation":"%s"}}}}`, keyName)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_SUBNET_PACKAGE_ID, filters)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	for _, itemPrice := range itemPrices {
+		if itemPrice.LocationGroupId == 0 {
+			return itemPrice, nil
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find item price for '%s'", keyName))
+}
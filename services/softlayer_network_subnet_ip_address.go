@@ -0,0 +1,124 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Subnet_IpAddress_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Subnet_IpAddress_Service(client softlayer.Client) *softLayer_Network_Subnet_IpAddress_Service {
+	return &softLayer_Network_Subnet_IpAddress_Service{
+		client: client,
+	}
+}
+
+func (slnsias *softLayer_Network_Subnet_IpAddress_Service) GetName() string {
+	return "SoftLayer_Network_Subnet_IpAddress"
+}
+
+func (slnsias *softLayer_Network_Subnet_IpAddress_Service) GetObject(id int) (datatypes.SoftLayer_Network_Subnet_IpAddress, error) {
+	response, errorCode, err := slnsias.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnsias.GetName(), id), slnsias.ipAddressObjectMask(), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, errors.New(errorMessage)
+	}
+
+	ipAddress := datatypes.SoftLayer_Network_Subnet_IpAddress{}
+	err = json.Unmarshal(response, &ipAddress)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	return ipAddress, nil
+}
+
+func (slnsias *softLayer_Network_Subnet_IpAddress_Service) GetByIpAddress(ipAddress string) (datatypes.SoftLayer_Network_Subnet_IpAddress, error) {
+	response, errorCode, err := slnsias.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/getByIpAddress/%s.json", slnsias.GetName(), ipAddress), slnsias.ipAddressObjectMask(), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress#getByIpAddress, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, errors.New(errorMessage)
+	}
+
+	subnetIpAddress := datatypes.SoftLayer_Network_Subnet_IpAddress{}
+	err = json.Unmarshal(response, &subnetIpAddress)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, err
+	}
+
+	if subnetIpAddress.Id == 0 {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress{}, errors.New(fmt.Sprintf("softlayer-go: could not find IP address '%s'", ipAddress))
+	}
+
+	return subnetIpAddress, nil
+}
+
+func (slnsias *softLayer_Network_Subnet_IpAddress_Service) SetNote(id int, note string) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Subnet_IpAddress_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Subnet_IpAddress{
+			datatypes.SoftLayer_Network_Subnet_IpAddress{Note: note},
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnsias.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slnsias.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to set note of IP address with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+//Private methods
+
+func (slnsias *softLayer_Network_Subnet_IpAddress_Service) ipAddressObjectMask() []string {
+	return []string{
+		"id",
+		"ipAddress",
+		"subnetId",
+		"isBroadcast",
+		"isGateway",
+		"isNetwork",
+		"isReserved",
+		"note",
+		"subnet.id",
+		"subnet.networkIdentifier",
+		"subnet.cidr",
+		"subnet.subnetType",
+		"virtualGuest.id",
+		"virtualGuest.hostname",
+		"virtualGuest.domain",
+		"hardware.id",
+		"hardware.hostname",
+		"hardware.domain",
+	}
+}
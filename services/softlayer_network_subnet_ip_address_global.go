@@ -0,0 +1,149 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	GLOBAL_IPV4_KEY_NAME = "GLOBAL_IPV4"
+	GLOBAL_IPV6_KEY_NAME = "GLOBAL_IPV6"
+)
+
+type softLayer_Network_Subnet_IpAddress_Global_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Subnet_IpAddress_Global_Service(client softlayer.Client) *softLayer_Network_Subnet_IpAddress_Global_Service {
+	return &softLayer_Network_Subnet_IpAddress_Global_Service{
+		client: client,
+	}
+}
+
+func (slnsiags *softLayer_Network_Subnet_IpAddress_Global_Service) GetName() string {
+	return "SoftLayer_Network_Subnet_IpAddress_Global"
+}
+
+func (slnsiags *softLayer_Network_Subnet_IpAddress_Global_Service) GetObject(id int) (datatypes.SoftLayer_Network_Subnet_IpAddress_Global, error) {
+	objectMask := []string{
+		"id",
+		"ipAddressId",
+		"destinationIpAddressId",
+		"description",
+		"ipAddress.id",
+		"ipAddress.ipAddress",
+		"destinationIpAddress.id",
+		"destinationIpAddress.ipAddress",
+	}
+
+	response, errorCode, err := slnsiags.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnsiags.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress_Global#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, errors.New(errorMessage)
+	}
+
+	globalIp := datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}
+	err = json.Unmarshal(response, &globalIp)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Subnet_IpAddress_Global{}, err
+	}
+
+	return globalIp, nil
+}
+
+func (slnsiags *softLayer_Network_Subnet_IpAddress_Global_Service) Route(id int, destinationIpAddress string) (bool, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Network_Subnet_IpAddress_Global_InitParameters{Parameters: []interface{}{destinationIpAddress}})
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnsiags.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/route.json", slnsiags.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress_Global#route, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to route global IP with id '%d' to '%s', got '%s' as response from the API.", id, destinationIpAddress, res))
+	}
+
+	return true, nil
+}
+
+func (slnsiags *softLayer_Network_Subnet_IpAddress_Global_Service) Unroute(id int) (bool, error) {
+	response, errorCode, err := slnsiags.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/unroute.json", slnsiags.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Subnet_IpAddress_Global#unroute, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to unroute global IP with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnsiags *softLayer_Network_Subnet_IpAddress_Global_Service) OrderGlobalIp(ipv6 bool) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	keyName := GLOBAL_IPV4_KEY_NAME
+	if ipv6 {
+		keyName = GLOBAL_IPV6_KEY_NAME
+	}
+
+	productPackageService, err := slnsiags.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"item":{"keyName":{"operation":"%s"}}}}`, keyName)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_SUBNET_PACKAGE_ID, filters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	priceId := 0
+	for _, itemPrice := range itemPrices {
+		if itemPrice.LocationGroupId == 0 {
+			priceId = itemPrice.Id
+			break
+		}
+	}
+
+	if priceId == 0 {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(fmt.Sprintf("Failed to find item price for '%s'", keyName))
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Subnet{
+		ComplexType: NETWORK_SUBNET_ORDER_COMPLEX_TYPE,
+		PackageId:   NETWORK_SUBNET_PACKAGE_ID,
+		Prices: []datatypes.SoftLayer_Product_Item_Price{
+			datatypes.SoftLayer_Product_Item_Price{Id: priceId},
+		},
+		Quantity: 1,
+	}
+
+	productOrderService, err := slnsiags.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkSubnet(order)
+}
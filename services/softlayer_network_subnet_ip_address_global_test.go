@@ -0,0 +1,199 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Subnet_IpAddress_Global", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		globalIpService softlayer.SoftLayer_Network_Subnet_IpAddress_Global_Service
+		err             error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		globalIpService, err = fakeClient.GetSoftLayer_Network_Subnet_IpAddress_Global_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(globalIpService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := globalIpService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Subnet_IpAddress_Global"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_IpAddress_Global_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the global IP with its destination", func() {
+			globalIp, err := globalIpService.GetObject(6601)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(globalIp.Id).To(Equal(6601))
+			Expect(globalIp.IpAddress.IpAddress).To(Equal("169.55.1.20"))
+			Expect(globalIp.DestinationIpAddress.IpAddress).To(Equal("169.45.12.34"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet_IpAddress_Global/6601/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.GetObject(6601)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.GetObject(6601)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Route", func() {
+		It("routes the global IP to the destination IP address", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			routed, err := globalIpService.Route(6601, "169.45.12.35")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Subnet_IpAddress_Global/6601/route.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["169.45.12.35"]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := globalIpService.Route(6601, "169.45.12.35")
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.Route(6601, "169.45.12.35")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.Route(6601, "169.45.12.35")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Unroute", func() {
+		It("unroutes the global IP", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			unrouted, err := globalIpService.Unroute(6601)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(unrouted).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Subnet_IpAddress_Global/6601/unroute.json"))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := globalIpService.Unroute(6601)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.Unroute(6601)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := globalIpService.Unroute(6601)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#OrderGlobalIp", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getItemPrices_global_ip.json",
+				"SoftLayer_Product_Order_placeOrder_subnet.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("orders a global IPv4 address", func() {
+			receipt, err := globalIpService.OrderGlobalIp(false)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(8765434))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"GLOBAL_IPV4"}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Subnet","packageId":0,"prices":[{"id":2147,"locationGroupId":0}],"quantity":1}]}`))
+		})
+
+		It("orders a global IPv6 address", func() {
+			_, err := globalIpService.OrderGlobalIp(true)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"GLOBAL_IPV6"}}}}`))
+		})
+
+		It("fails when no price is found", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte("[]")}
+
+			_, err := globalIpService.OrderGlobalIp(false)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+	})
+})
@@ -0,0 +1,177 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Subnet_IpAddress", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkSubnetIpAddressService softlayer.SoftLayer_Network_Subnet_IpAddress_Service
+		err                           error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkSubnetIpAddressService, err = fakeClient.GetSoftLayer_Network_Subnet_IpAddress_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkSubnetIpAddressService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkSubnetIpAddressService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Subnet_IpAddress"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_IpAddress_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the IP address with its subnet and assignment", func() {
+			ipAddress, err := networkSubnetIpAddressService.GetObject(44003)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipAddress.IpAddress).To(Equal("169.45.12.34"))
+			Expect(ipAddress.Subnet.Cidr).To(Equal(29))
+			Expect(ipAddress.VirtualGuest.Id).To(Equal(1234567))
+			Expect(ipAddress.Hardware).To(BeNil())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet_IpAddress/44003/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.GetObject(44003)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.GetObject(44003)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetByIpAddress", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_IpAddress_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the IP address matching the given address", func() {
+			ipAddress, err := networkSubnetIpAddressService.GetByIpAddress("169.45.12.34")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(ipAddress.Id).To(Equal(44003))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet_IpAddress/getByIpAddress/169.45.12.34.json"))
+		})
+
+		It("fails when the IP address is unknown", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("{}")
+
+			_, err := networkSubnetIpAddressService.GetByIpAddress("169.45.12.34")
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.GetByIpAddress("169.45.12.34")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.GetByIpAddress("169.45.12.34")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#SetNote", func() {
+		It("sets the note of the IP address", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			edited, err := networkSubnetIpAddressService.SetNote(44005, "reserved for vip")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Subnet_IpAddress/44005/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"note":"reserved for vip"}]}`))
+		})
+
+		It("sends an empty note to clear it", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			_, err := networkSubnetIpAddressService.SetNote(44005, "")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"note":""}]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := networkSubnetIpAddressService.SetNote(44005, "fake-note")
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.SetNote(44005, "fake-note")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetIpAddressService.SetNote(44005, "fake-note")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
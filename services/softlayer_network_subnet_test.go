@@ -0,0 +1,331 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Subnet", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkSubnetService softlayer.SoftLayer_Network_Subnet_Service
+		err                  error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkSubnetService, err = fakeClient.GetSoftLayer_Network_Subnet_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkSubnetService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkSubnetService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Subnet"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the subnet", func() {
+			subnet, err := networkSubnetService.GetObject(987650)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(subnet.Id).To(Equal(987650))
+			Expect(subnet.NetworkIdentifier).To(Equal("169.45.12.32"))
+			Expect(subnet.Cidr).To(Equal(29))
+			Expect(subnet.Gateway).To(Equal("169.45.12.33"))
+			Expect(subnet.UsableIpAddressCount).To(Equal("5"))
+			Expect(subnet.Datacenter.Name).To(Equal("dal10"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet/987650/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetObject(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetObject(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetIpAddresses", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_Service_getIpAddresses.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the IP addresses of the subnet with their assignments", func() {
+			ipAddresses, err := networkSubnetService.GetIpAddresses(987650)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(ipAddresses)).To(Equal(7))
+			Expect(ipAddresses[0].IsNetwork).To(BeTrue())
+			Expect(ipAddresses[2].VirtualGuest.Hostname).To(Equal("app-01"))
+			Expect(ipAddresses[2].Note).To(Equal("app-01"))
+			Expect(ipAddresses[3].Hardware.Id).To(Equal(7654321))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet/987650/getIpAddresses.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetIpAddresses(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetIpAddresses(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetFreeIpAddresses", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_Service_getIpAddresses.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the unassigned and unreserved IP addresses of the subnet", func() {
+			ipAddresses, err := networkSubnetService.GetFreeIpAddresses(987650)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(ipAddresses)).To(Equal(2))
+			Expect(ipAddresses[0].IpAddress).To(Equal("169.45.12.36"))
+			Expect(ipAddresses[1].IpAddress).To(Equal("169.45.12.37"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetFreeIpAddresses(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetFreeIpAddresses(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Route", func() {
+		It("routes the subnet to the given end point", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			routed, err := networkSubnetService.Route(987650, "SoftLayer_Network_Subnet_IpAddress", "169.45.12.34")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(routed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Subnet/987650/route.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["SoftLayer_Network_Subnet_IpAddress","169.45.12.34"]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := networkSubnetService.Route(987650, "SoftLayer_Network_Subnet_IpAddress", "169.45.12.34")
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.Route(987650, "SoftLayer_Network_Subnet_IpAddress", "169.45.12.34")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.Route(987650, "SoftLayer_Network_Subnet_IpAddress", "169.45.12.34")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ClearRoute", func() {
+		It("clears the route of the subnet", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			cleared, err := networkSubnetService.ClearRoute(987650)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(cleared).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Subnet/987650/clearRoute.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.ClearRoute(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.ClearRoute(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#OrderSubnet", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getItemPrices_subnet.json",
+				"SoftLayer_Product_Order_placeOrder_subnet.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("orders a portable subnet on the given VLAN", func() {
+			receipt, err := networkSubnetService.OrderSubnet(&softlayer.SubnetOrderOptions{
+				Type:     "PORTABLE",
+				Public:   true,
+				Capacity: 8,
+				VlanId:   1122,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(8765434))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"8_PORTABLE_PUBLIC_IP_ADDRESSES"}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Subnet","packageId":0,"prices":[{"id":13,"locationGroupId":0}],"quantity":1,"endPointVlanId":1122}]}`))
+		})
+
+		It("orders a static subnet routed to the given IP address", func() {
+			_, err := networkSubnetService.OrderSubnet(&softlayer.SubnetOrderOptions{
+				Type:                "static",
+				Capacity:            4,
+				EndPointIpAddressId: 44003,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"4_STATIC_PRIVATE_IP_ADDRESSES"}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"endPointIpAddressId":44003`))
+		})
+
+		It("fails when a portable subnet has no VLAN", func() {
+			_, err := networkSubnetService.OrderSubnet(&softlayer.SubnetOrderOptions{Type: "PORTABLE", Capacity: 8})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		It("fails when a static subnet has no end point IP address", func() {
+			_, err := networkSubnetService.OrderSubnet(&softlayer.SubnetOrderOptions{Type: "STATIC", Capacity: 4})
+			Expect(err).To(HaveOccurred())
+		})
+
+		It("fails for unsupported subnet types", func() {
+			_, err := networkSubnetService.OrderSubnet(&softlayer.SubnetOrderOptions{Type: "GLOBAL", Capacity: 1, VlanId: 1122})
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#GetReverseDomainRecords", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Subnet_Service_getReverseDomainRecords.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the reverse zones of the subnet with their PTR records", func() {
+			reverseDomains, err := networkSubnetService.GetReverseDomainRecords(987650)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(reverseDomains)).To(Equal(1))
+			Expect(reverseDomains[0].Name).To(Equal("12.45.169.in-addr.arpa"))
+			Expect(len(reverseDomains[0].ResourceRecords)).To(Equal(2))
+			Expect(reverseDomains[0].ResourceRecords[0].Host).To(Equal("34"))
+			Expect(reverseDomains[0].ResourceRecords[0].Data).To(Equal("mail.example.com."))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Subnet/987650/getReverseDomainRecords.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetReverseDomainRecords(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkSubnetService.GetReverseDomainRecords(987650)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+})
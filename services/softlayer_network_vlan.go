@@ -0,0 +1,218 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+const (
+	NETWORK_VLAN_ORDER_COMPLEX_TYPE = "SoftLayer_Container_Product_Order_Network_Vlan"
+	NETWORK_VLAN_PACKAGE_ID         = 0
+
+	PUBLIC_NETWORK_VLAN_KEY_NAME  = "PUBLIC_NETWORK_VLAN"
+	PRIVATE_NETWORK_VLAN_KEY_NAME = "PRIVATE_NETWORK_VLAN"
+)
+
+type softLayer_Network_Vlan_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Vlan_Service(client softlayer.Client) *softLayer_Network_Vlan_Service {
+	return &softLayer_Network_Vlan_Service{
+		client: client,
+	}
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) GetName() string {
+	return "SoftLayer_Network_Vlan"
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) GetObject(id int) (datatypes.SoftLayer_Network_Vlan, error) {
+	objectMask := []string{
+		"id",
+		"accountId",
+		"name",
+		"note",
+		"networkSpace",
+		"primarySubnetId",
+		"vlanNumber",
+		"modifyDate",
+		"primaryRouter.id",
+		"primaryRouter.hostname",
+		"primaryRouter.datacenter",
+		"subnets",
+		"virtualGuests.id",
+		"virtualGuests.hostname",
+		"virtualGuests.domain",
+		"hardware.id",
+		"hardware.hostname",
+		"hardware.domain",
+	}
+
+	response, errorCode, err := slnvs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnvs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Vlan{}, errors.New(errorMessage)
+	}
+
+	networkVlan := datatypes.SoftLayer_Network_Vlan{}
+	err = json.Unmarshal(response, &networkVlan)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Vlan{}, err
+	}
+
+	return networkVlan, nil
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) EditObject(id int, template datatypes.SoftLayer_Network_Vlan_Template) (bool, error) {
+	parameters := datatypes.SoftLayer_Network_Vlan_Template_Parameters{
+		Parameters: []datatypes.SoftLayer_Network_Vlan_Template{template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slnvs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/editObject.json", slnvs.GetName(), id), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan#editObject, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to edit network vlan with id '%d', got '%s' as response from the API.", id, res))
+	}
+
+	return true, nil
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) GetFirewallInterfaces(id int) ([]datatypes.SoftLayer_Network_Firewall_Interface, error) {
+	response, errorCode, err := slnvs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getFirewallInterfaces.json", slnvs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Interface{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan#getFirewallInterfaces, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Firewall_Interface{}, errors.New(errorMessage)
+	}
+
+	firewallInterfaces := []datatypes.SoftLayer_Network_Firewall_Interface{}
+	err = json.Unmarshal(response, &firewallInterfaces)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Interface{}, err
+	}
+
+	return firewallInterfaces, nil
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) GetBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error) {
+	response, errorCode, err := slnvs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBillingItem.json", slnvs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan#getBillingItem, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Billing_Item{}, errors.New(errorMessage)
+	}
+
+	billingItem := datatypes.SoftLayer_Billing_Item{}
+	err = json.Unmarshal(response, &billingItem)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	return billingItem, nil
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) CancelObject(id int, options *softlayer.CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	billingItem, err := slnvs.GetBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	if billingItem.Id == 0 {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, errors.New(fmt.Sprintf("No billing item found for network vlan with id '%d'", id))
+	}
+
+	cancellationRequestService, err := slnvs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return cancellationRequestService.CancelBillingItem(billingItem.Id, options)
+}
+
+func (slnvs *softLayer_Network_Vlan_Service) OrderVlan(options *softlayer.NetworkVlanOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	if options == nil || options.Datacenter == "" {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New("softlayer-go: datacenter is required to order a network vlan")
+	}
+
+	keyName := PRIVATE_NETWORK_VLAN_KEY_NAME
+	if options.Public {
+		keyName = PUBLIC_NETWORK_VLAN_KEY_NAME
+	}
+
+	price, err := slnvs.findVlanItemPrice(keyName)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Network_Vlan{
+		ComplexType: NETWORK_VLAN_ORDER_COMPLEX_TYPE,
+		Location:    options.Datacenter,
+		PackageId:   NETWORK_VLAN_PACKAGE_ID,
+		Prices: []datatypes.SoftLayer_Product_Item_Price{
+			datatypes.SoftLayer_Product_Item_Price{Id: price.Id},
+		},
+		Quantity: 1,
+		Name:     options.Name,
+		RouterId: options.RouterId,
+	}
+
+	productOrderService, err := slnvs.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return productOrderService.PlaceContainerOrderNetworkVlan(order)
+}
+
+//Private methods
+
+func (slnvs *softLayer_Network_Vlan_Service) findVlanItemPrice(keyName string) (datatypes.SoftLayer_Product_Item_Price, error) {
+	productPackageService, err := slnvs.client.GetSoftLayer_Product_Package_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	filters := fmt.Sprintf(`{"itemPrices":{"item":{"keyName":{"operation":"%s"}}}}`, keyName)
+	itemPrices, err := productPackageService.GetItemPrices(NETWORK_VLAN_PACKAGE_ID, filters)
+	if err != nil {
+		return datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	for _, itemPrice := range itemPrices {
+		if itemPrice.LocationGroupId == 0 {
+			return itemPrice, nil
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find item price for '%s'", keyName))
+}
@@ -0,0 +1,168 @@
+package services
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	common "github.com/maximilien/softlayer-go/common"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+)
+
+type softLayer_Network_Vlan_Firewall_Service struct {
+	client softlayer.Client
+}
+
+func NewSoftLayer_Network_Vlan_Firewall_Service(client softlayer.Client) *softLayer_Network_Vlan_Firewall_Service {
+	return &softLayer_Network_Vlan_Firewall_Service{
+		client: client,
+	}
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) GetName() string {
+	return "SoftLayer_Network_Vlan_Firewall"
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) GetObject(id int) (datatypes.SoftLayer_Network_Vlan_Firewall, error) {
+	objectMask := []string{
+		"id",
+		"firewallType",
+		"fullyQualifiedDomainName",
+		"primaryIpAddress",
+		"networkVlan.id",
+		"networkVlan.vlanNumber",
+		"networkVlan.firewallInterfaces.id",
+		"networkVlan.firewallInterfaces.name",
+		"networkVlan.firewallInterfaces.firewallContextAccessControlLists",
+	}
+
+	response, errorCode, err := slnvfs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getObject.json", slnvfs.GetName(), id), objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Network_Vlan_Firewall{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan_Firewall#getObject, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Network_Vlan_Firewall{}, errors.New(errorMessage)
+	}
+
+	firewall := datatypes.SoftLayer_Network_Vlan_Firewall{}
+	err = json.Unmarshal(response, &firewall)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Vlan_Firewall{}, err
+	}
+
+	return firewall, nil
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) GetRules(id int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error) {
+	response, errorCode, err := slnvfs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getRules.json", slnvfs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Vlan_Firewall#getRules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, errors.New(errorMessage)
+	}
+
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	err = json.Unmarshal(response, &rules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	return rules, nil
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) UpdateRules(id int, context string, direction string, rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	accessControlListId, err := slnvfs.findAccessControlListId(id, context, direction)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return slnvfs.createUpdateRequest(accessControlListId, false, rules)
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) Bypass(id int, context string, direction string) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	accessControlListId, err := slnvfs.findAccessControlListId(id, context, direction)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return slnvfs.createUpdateRequest(accessControlListId, true, []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{})
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) Unbypass(id int, context string, direction string) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	accessControlListId, err := slnvfs.findAccessControlListId(id, context, direction)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	rules, err := slnvfs.getAccessControlListRules(accessControlListId)
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return slnvfs.createUpdateRequest(accessControlListId, false, rules)
+}
+
+//Private methods
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) createUpdateRequest(accessControlListId int, bypass bool, rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) (datatypes.SoftLayer_Network_Firewall_Update_Request, error) {
+	updateRequestService, err := slnvfs.client.GetSoftLayer_Network_Firewall_Update_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Network_Firewall_Update_Request{}, err
+	}
+
+	return updateRequestService.CreateObject(datatypes.SoftLayer_Network_Firewall_Update_Request{
+		FirewallContextAccessControlListId: accessControlListId,
+		BypassFlag:                         bypass,
+		Rules:                              rules,
+	})
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) findAccessControlListId(id int, context string, direction string) (int, error) {
+	firewall, err := slnvfs.GetObject(id)
+	if err != nil {
+		return 0, err
+	}
+
+	if firewall.NetworkVlan != nil {
+		for _, firewallInterface := range firewall.NetworkVlan.FirewallInterfaces {
+			if firewallInterface.Name != context {
+				continue
+			}
+
+			for _, accessControlList := range firewallInterface.FirewallContextAccessControlLists {
+				if accessControlList.Direction == direction {
+					return accessControlList.Id, nil
+				}
+			}
+		}
+	}
+
+	return 0, errors.New(fmt.Sprintf("No '%s' access control list found for the '%s' interface of vlan firewall with id '%d'", direction, context, id))
+}
+
+func (slnvfs *softLayer_Network_Vlan_Firewall_Service) getAccessControlListRules(accessControlListId int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error) {
+	response, errorCode, err := slnvfs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("SoftLayer_Network_Firewall_AccessControlList/%d/getRules.json", accessControlListId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Network_Firewall_AccessControlList#getRules, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, errors.New(errorMessage)
+	}
+
+	rules := []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}
+	err = json.Unmarshal(response, &rules)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule{}, err
+	}
+
+	return rules, nil
+}
@@ -0,0 +1,269 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Vlan_Firewall", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkVlanFirewallService softlayer.SoftLayer_Network_Vlan_Firewall_Service
+		err                        error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkVlanFirewallService, err = fakeClient.GetSoftLayer_Network_Vlan_Firewall_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkVlanFirewallService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkVlanFirewallService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Vlan_Firewall"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Vlan_Firewall_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the firewall with its access control lists", func() {
+			firewall, err := networkVlanFirewallService.GetObject(7001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(firewall.Id).To(Equal(7001))
+			Expect(firewall.PrimaryIpAddress).To(Equal("169.45.1.10"))
+			Expect(firewall.NetworkVlan.VlanNumber).To(Equal(1401))
+			Expect(firewall.NetworkVlan.FirewallInterfaces).To(HaveLen(2))
+			Expect(firewall.NetworkVlan.FirewallInterfaces[1].FirewallContextAccessControlLists[1].Id).To(Equal(803))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Vlan_Firewall/7001/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanFirewallService.GetObject(7001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanFirewallService.GetObject(7001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Vlan_Firewall_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the rules of the firewall", func() {
+			rules, err := networkVlanFirewallService.GetRules(7001)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(rules).To(HaveLen(2))
+			Expect(rules[0].Action).To(Equal("permit"))
+			Expect(rules[1].Action).To(Equal("deny"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Vlan_Firewall/7001/getRules.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanFirewallService.GetRules(7001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanFirewallService.GetRules(7001)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#UpdateRules", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Vlan_Firewall_Service_getObject.json",
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("submits the rules through an update request", func() {
+			rules, err := testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Vlan_Firewall_Service_getRules.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			updateRequest, err := networkVlanFirewallService.UpdateRules(7001, "outside", "in", unmarshalFirewallRules(rules))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(updateRequest.Id).To(Equal(5501))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Firewall_Update_Request.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"bypassFlag":false,"firewallContextAccessControlListId":803`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"notes":"default deny"`))
+		})
+
+		It("submits the rules to the access control list of the given context and direction", func() {
+			_, err := networkVlanFirewallService.UpdateRules(7001, "inside", "in", nil)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"bypassFlag":false,"firewallContextAccessControlListId":801`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.UpdateRules(7001, "outside", "in", nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.UpdateRules(7001, "outside", "in", nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Bypass", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Vlan_Firewall_Service_getObject.json",
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("submits a bypass update request without rules", func() {
+			_, err := networkVlanFirewallService.Bypass(7001, "outside", "in")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`{"parameters":[{"bypassFlag":true,"firewallContextAccessControlListId":803,"rules":[]}]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.Bypass(7001, "outside", "in")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.Bypass(7001, "outside", "in")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Unbypass", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Network_Vlan_Firewall_Service_getObject.json",
+				"SoftLayer_Network_Firewall_AccessControlList_Service_getRules.json",
+				"SoftLayer_Network_Firewall_Update_Request_Service_createObject.json",
+			})
+		})
+
+		It("resubmits the current rules of the access control list with the bypass turned off", func() {
+			_, err := networkVlanFirewallService.Unbypass(7001, "outside", "in")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(3))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"bypassFlag":false,"firewallContextAccessControlListId":803`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"action":"deny"`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.Unbypass(7001, "outside", "in")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+
+					_, err := networkVlanFirewallService.Unbypass(7001, "outside", "in")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("when the firewall has no access control list for the given context and direction", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"id":7001,"networkVlan":{"id":1122}}`)
+		})
+
+		It("fails to submit the update request", func() {
+			_, err := networkVlanFirewallService.Bypass(7001, "outside", "in")
+			Expect(err).To(HaveOccurred())
+		})
+	})
+})
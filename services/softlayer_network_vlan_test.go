@@ -0,0 +1,253 @@
+package services_test
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	slclientfakes "github.com/maximilien/softlayer-go/client/fakes"
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	testhelpers "github.com/maximilien/softlayer-go/test_helpers"
+)
+
+var _ = Describe("SoftLayer_Network_Vlan", func() {
+	var (
+		username, apiKey string
+
+		fakeClient *slclientfakes.FakeSoftLayerClient
+
+		networkVlanService softlayer.SoftLayer_Network_Vlan_Service
+		err                error
+	)
+
+	BeforeEach(func() {
+		username = os.Getenv("SL_USERNAME")
+		Expect(username).ToNot(Equal(""))
+
+		apiKey = os.Getenv("SL_API_KEY")
+		Expect(apiKey).ToNot(Equal(""))
+
+		fakeClient = slclientfakes.NewFakeSoftLayerClient(username, apiKey)
+		Expect(fakeClient).ToNot(BeNil())
+
+		networkVlanService, err = fakeClient.GetSoftLayer_Network_Vlan_Service()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(networkVlanService).ToNot(BeNil())
+	})
+
+	Context("#GetName", func() {
+		It("returns the name for the service", func() {
+			name := networkVlanService.GetName()
+			Expect(name).To(Equal("SoftLayer_Network_Vlan"))
+		})
+	})
+
+	Context("#GetObject", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Vlan_Service_getObject.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the network vlan with its subnets, guests and hardware", func() {
+			vlan, err := networkVlanService.GetObject(3344)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(vlan.Id).To(Equal(3344))
+			Expect(vlan.VlanNumber).To(Equal(1420))
+			Expect(vlan.NetworkSpace).To(Equal("PRIVATE"))
+			Expect(vlan.PrimaryRouter.Hostname).To(Equal("bcr01a.dal10"))
+			Expect(vlan.PrimaryRouter.Datacenter.Name).To(Equal("dal10"))
+
+			Expect(len(vlan.Subnets)).To(Equal(1))
+			Expect(vlan.Subnets[0].NetworkIdentifier).To(Equal("10.120.4.0"))
+			Expect(vlan.Subnets[0].Cidr).To(Equal(26))
+			Expect(len(vlan.VirtualGuests)).To(Equal(1))
+			Expect(vlan.VirtualGuests[0].Hostname).To(Equal("app-01"))
+			Expect(len(vlan.Hardware)).To(Equal(1))
+			Expect(vlan.Hardware[0].Id).To(Equal(7654321))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Network_Vlan/3344/getObject.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanService.GetObject(3344)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanService.GetObject(3344)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#EditObject", func() {
+		It("updates the name and note of the network vlan", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			name, note := "db-backend", "fake-note"
+			edited, err := networkVlanService.EditObject(3344, datatypes.SoftLayer_Network_Vlan_Template{Name: &name, Note: &note})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Vlan/3344/editObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"name":"db-backend","note":"fake-note"}]}`))
+		})
+
+		It("clears the note of the network vlan", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			note := ""
+			edited, err := networkVlanService.EditObject(3344, datatypes.SoftLayer_Network_Vlan_Template{Note: &note})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edited).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"note":""}]}`))
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			_, err := networkVlanService.EditObject(3344, datatypes.SoftLayer_Network_Vlan_Template{})
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkVlanService.EditObject(3344, datatypes.SoftLayer_Network_Vlan_Template{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := networkVlanService.EditObject(3344, datatypes.SoftLayer_Network_Vlan_Template{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetFirewallInterfaces", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Network_Vlan_Service_getFirewallInterfaces.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the firewall interfaces of the network vlan", func() {
+			firewallInterfaces, err := networkVlanService.GetFirewallInterfaces(3344)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(firewallInterfaces)).To(Equal(2))
+			Expect(firewallInterfaces[0].Name).To(Equal("inside"))
+			Expect(firewallInterfaces[1].Name).To(Equal("outside"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Network_Vlan/3344/getFirewallInterfaces.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanService.GetFirewallInterfaces(3344)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := networkVlanService.GetFirewallInterfaces(3344)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#CancelObject", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Network_Vlan_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_createObject.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("creates a cancellation request for the billing item of the network vlan", func() {
+			request, err := networkVlanService.CancelObject(3344, &softlayer.CancellationOptions{Immediate: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/createObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"items":[{"billingItemId":9012,"immediateCancellationFlag":true}]`))
+		})
+
+		It("fails when the network vlan has no billing item", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("{}")
+
+			_, err := networkVlanService.CancelObject(3344, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+	})
+
+	Context("#OrderVlan", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Product_Package_getItemPrices_network_vlan.json",
+				"SoftLayer_Product_Order_placeOrder_network_vlan.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("orders a private network vlan behind the given router", func() {
+			receipt, err := networkVlanService.OrderVlan(&softlayer.NetworkVlanOrderOptions{
+				Datacenter: "dal10",
+				RouterId:   42,
+				Name:       "app-backend",
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(8765433))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"PRIVATE_NETWORK_VLAN"}}}}`))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[{"complexType":"SoftLayer_Container_Product_Order_Network_Vlan","location":"dal10","packageId":0,"prices":[{"id":2018,"locationGroupId":0}],"quantity":1,"name":"app-backend","routerId":42}]}`))
+		})
+
+		It("looks up the public vlan price for public vlans", func() {
+			_, err := networkVlanService.OrderVlan(&softlayer.NetworkVlanOrderOptions{Datacenter: "dal10", Public: true})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectFilterAndObjectMaskFilters).To(Equal(`{"itemPrices":{"item":{"keyName":{"operation":"PUBLIC_NETWORK_VLAN"}}}}`))
+		})
+
+		It("fails when no datacenter is given", func() {
+			_, err := networkVlanService.OrderVlan(&softlayer.NetworkVlanOrderOptions{})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+	})
+})
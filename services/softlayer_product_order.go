@@ -87,6 +87,130 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerforman
 	return receipt, nil
 }
 
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkPerformanceStorageNfs(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeContainerOrderNetworkPerformanceStorageNfs, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkStorageAsAService(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeContainerOrderNetworkStorageAsAService, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkStorageAsAServiceUpgrade(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeContainerOrderNetworkStorageAsAServiceUpgrade, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeContainerOrderNetworkStorageEnterpriseSnapshotSpace, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
 func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
 	parameters := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade_Parameters{
 		Parameters: []datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
@@ -117,3 +241,127 @@ func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderVirtualGuestUpgr
 
 	return receipt, nil
 }
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderHardwareServer(order datatypes.SoftLayer_Container_Product_Order_Hardware_Server) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Hardware_Server_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Hardware_Server{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeOrder, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkVlan(order datatypes.SoftLayer_Container_Product_Order_Network_Vlan) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_Vlan_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_Vlan{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeOrder, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) PlaceContainerOrderNetworkSubnet(order datatypes.SoftLayer_Container_Product_Order_Network_Subnet) (datatypes.SoftLayer_Container_Product_Order_Receipt, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Network_Subnet_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Network_Subnet{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/placeOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#placeOrder, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, errors.New(errorMessage)
+	}
+
+	receipt := datatypes.SoftLayer_Container_Product_Order_Receipt{}
+	err = json.Unmarshal(responseBytes, &receipt)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Receipt{}, err
+	}
+
+	return receipt, nil
+}
+
+func (slpo *softLayer_Product_Order_Service) VerifyContainerOrderHardwareServer(order datatypes.SoftLayer_Container_Product_Order_Hardware_Server) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error) {
+	parameters := datatypes.SoftLayer_Container_Product_Order_Hardware_Server_Parameters{
+		Parameters: []datatypes.SoftLayer_Container_Product_Order_Hardware_Server{
+			order,
+		},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	responseBytes, errorCode, err := slpo.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/verifyOrder.json", slpo.GetName()), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Order#verifyOrder, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, errors.New(errorMessage)
+	}
+
+	verifiedOrder := datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}
+	err = json.Unmarshal(responseBytes, &verifiedOrder)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Product_Order_Hardware_Server{}, err
+	}
+
+	return verifiedOrder, nil
+}
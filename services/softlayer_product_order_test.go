@@ -115,6 +115,147 @@ var _ = Describe("SoftLayer_Product_Order", func() {
 		})
 	})
 
+	Context("#PlaceContainerOrderNetworkPerformanceStorageNfs", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an instance of datatypes.SoftLayer_Container_Product_Order_Receipt", func() {
+			receipt, err := productOrderService.PlaceContainerOrderNetworkPerformanceStorageNfs(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt).ToNot(BeNil())
+			Expect(receipt.OrderId).To(Equal(123))
+		})
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkPerformanceStorageNfs(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkPerformanceStorageNfs(datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceContainerOrderNetworkStorageAsAService", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an instance of datatypes.SoftLayer_Container_Product_Order_Receipt", func() {
+			receipt, err := productOrderService.PlaceContainerOrderNetworkStorageAsAService(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt).ToNot(BeNil())
+			Expect(receipt.OrderId).To(Equal(123))
+		})
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageAsAService(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageAsAService(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceContainerOrderNetworkStorageAsAServiceUpgrade", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an instance of datatypes.SoftLayer_Container_Product_Order_Receipt", func() {
+			receipt, err := productOrderService.PlaceContainerOrderNetworkStorageAsAServiceUpgrade(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt).ToNot(BeNil())
+			Expect(receipt.OrderId).To(Equal(123))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageAsAServiceUpgrade(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageAsAServiceUpgrade(datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an instance of datatypes.SoftLayer_Container_Product_Order_Receipt", func() {
+			receipt, err := productOrderService.PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt).ToNot(BeNil())
+			Expect(receipt.OrderId).To(Equal(123))
+		})
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#PlaceContainerOrderVirtualGuestUpgrade", func() {
 		BeforeEach(func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
@@ -150,4 +291,79 @@ var _ = Describe("SoftLayer_Product_Order", func() {
 			})
 		})
 	})
+
+	Context("#PlaceContainerOrderHardwareServer", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder_hardware_server.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns an instance of datatypes.SoftLayer_Container_Product_Order_Receipt", func() {
+			receipt, err := productOrderService.PlaceContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(receipt.OrderId).To(Equal(8765432))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.PlaceContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#VerifyContainerOrderHardwareServer", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_verifyOrder_hardware_server.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the verified order", func() {
+			order, err := productOrderService.VerifyContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(order.PackageId).To(Equal(200))
+			Expect(order.PresetId).To(Equal(64))
+			Expect(len(order.Prices)).To(Equal(3))
+			Expect(order.Hardware[0].Hostname).To(Equal("bm-test"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/verifyOrder.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productOrderService.VerifyContainerOrderHardwareServer(datatypes.SoftLayer_Container_Product_Order_Hardware_Server{})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
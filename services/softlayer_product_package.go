@@ -30,6 +30,66 @@ func (slpp *softLayer_Product_Package_Service) GetName() string {
 	return "SoftLayer_Product_Package"
 }
 
+func (slpp *softLayer_Product_Package_Service) GetActivePresets(packageId int) ([]datatypes.SoftLayer_Product_Package_Preset, error) {
+	objectMasks := []string{
+		"id",
+		"keyName",
+		"name",
+		"description",
+		"packageId",
+	}
+
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getActivePresets.json", slpp.GetName(), packageId), objectMasks, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Package_Preset{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getActivePresets, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Product_Package_Preset{}, errors.New(errorMessage)
+	}
+
+	presets := []datatypes.SoftLayer_Product_Package_Preset{}
+	err = json.Unmarshal(response, &presets)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Package_Preset{}, err
+	}
+
+	return presets, nil
+}
+
+func (slpp *softLayer_Product_Package_Service) GetRegions(packageId int) ([]datatypes.SoftLayer_Location_Region, error) {
+	objectMasks := []string{
+		"description",
+		"keyname",
+		"sortOrder",
+		"location.locationId",
+		"location.location.id",
+		"location.location.name",
+		"location.location.longName",
+		"location.location.priceGroups.id",
+		"location.location.priceGroups.name",
+	}
+
+	response, errorCode, err := slpp.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getRegions.json", slpp.GetName(), packageId), objectMasks, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Location_Region{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Product_Package#getRegions, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Location_Region{}, errors.New(errorMessage)
+	}
+
+	regions := []datatypes.SoftLayer_Location_Region{}
+	err = json.Unmarshal(response, &regions)
+	if err != nil {
+		return []datatypes.SoftLayer_Location_Region{}, err
+	}
+
+	return regions, nil
+}
+
 func (slpp *softLayer_Product_Package_Service) GetItemPrices(packageId int, filters string) ([]datatypes.SoftLayer_Product_Item_Price, error) {
 	objectMasks := []string{
 		"id",
@@ -39,6 +99,12 @@ func (slpp *softLayer_Product_Package_Service) GetItemPrices(packageId int, filt
 		"item.units",
 		"item.description",
 		"item.capacity",
+		"item.capacityMinimum",
+		"item.capacityMaximum",
+		"categories.categoryCode",
+		"capacityRestrictionType",
+		"capacityRestrictionMinimum",
+		"capacityRestrictionMaximum",
 	}
 
 	var response []byte
@@ -268,4 +268,85 @@ var _ = Describe("SoftLayer_Product_Package", func() {
 			})
 		})
 	})
+
+	Context("#GetRegions", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Package_getRegions.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the regions the package is offered in", func() {
+			regions, err := productPackageService.GetRegions(759)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(regions)).To(Equal(2))
+			Expect(regions[0].Keyname).To(Equal("DALLAS10"))
+			Expect(regions[0].Location.LocationId).To(Equal(1441195))
+			Expect(regions[0].Location.Location.Name).To(Equal("dal10"))
+			Expect(regions[0].Location.Location.PriceGroups[0].Id).To(Equal(509))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Product_Package/759/getRegions.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productPackageService.GetRegions(759)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productPackageService.GetRegions(759)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetActivePresets", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Package_getActivePresets.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the active presets of the package", func() {
+			presets, err := productPackageService.GetActivePresets(200)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(len(presets)).To(Equal(2))
+			Expect(presets[0].Id).To(Equal(64))
+			Expect(presets[0].KeyName).To(Equal("S1270_32GB_1X1TB_NONRAID"))
+			Expect(presets[0].PackageId).To(Equal(200))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Product_Package/200/getActivePresets.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productPackageService.GetActivePresets(200)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := productPackageService.GetActivePresets(200)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
 })
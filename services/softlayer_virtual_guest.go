@@ -7,13 +7,18 @@ import (
 	"errors"
 	"fmt"
 	"net/url"
+	"os"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
 
+	boshlog "github.com/cloudfoundry/bosh-utils/logger"
+	boshretry "github.com/cloudfoundry/bosh-utils/retrystrategy"
 	common "github.com/maximilien/softlayer-go/common"
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 	softlayer "github.com/maximilien/softlayer-go/softlayer"
+	"github.com/pivotal-golang/clock"
 )
 
 const (
@@ -81,28 +86,96 @@ func (slvgs *softLayer_Virtual_Guest_Service) CreateObject(template datatypes.So
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) ReloadOperatingSystem(instanceId int, template datatypes.Image_Template_Config) error {
-	parameter := [2]interface{}{"FORCE", template}
-	parameters := map[string]interface{}{
-		"parameters": parameter,
-	}
+	return slvgs.ConfirmReloadOperatingSystem(instanceId, "FORCE", template)
+}
 
-	requestBody, err := json.Marshal(parameters)
+func (slvgs *softLayer_Virtual_Guest_Service) GetReloadOperatingSystemToken(instanceId int, template datatypes.Image_Template_Config) (string, error) {
+	response, err := slvgs.reloadOperatingSystem(instanceId, "", template)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	token := ""
+	err = json.Unmarshal(response, &token)
 	if err != nil {
-		return err
+		return "", err
 	}
 
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#reloadOperatingSystem, HTTP error code: '%d'", errorCode)
-		return errors.New(errorMessage)
+	if token == "" {
+		return "", errors.New(fmt.Sprintf("Failed to get OS reload token for instance with id '%d', got '%s' as response from the API.", instanceId, string(response[:])))
 	}
 
-	if res := string(response[:]); res != `"1"` {
-		return errors.New(fmt.Sprintf("Failed to reload OS on instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	return token, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ConfirmReloadOperatingSystem(instanceId int, token string, template datatypes.Image_Template_Config) error {
+	_, err := slvgs.reloadOperatingSystem(instanceId, token, template)
+
+	return err
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) WaitForReloadOperatingSystem(instanceId int) error {
+	SL_RELOAD_OS_TIMEOUT, err := strconv.Atoi(os.Getenv("SL_RELOAD_OS_TIMEOUT"))
+	if err != nil || SL_RELOAD_OS_TIMEOUT == 0 {
+		SL_RELOAD_OS_TIMEOUT = 1800
+	}
+	SL_RELOAD_OS_POLLING_INTERVAL, err := strconv.Atoi(os.Getenv("SL_RELOAD_OS_POLLING_INTERVAL"))
+	if err != nil || SL_RELOAD_OS_POLLING_INTERVAL == 0 {
+		SL_RELOAD_OS_POLLING_INTERVAL = 10
+	}
+
+	reloadTransaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	execStmtRetryable := boshretry.NewRetryable(
+		func() (bool, error) {
+			activeTransaction, err := slvgs.GetActiveTransaction(instanceId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get active transaction of instance with id `%d` due to `%s`, retrying...", instanceId, err.Error()))
+			}
+
+			if activeTransaction.Id != 0 {
+				if reloadTransaction.Id == 0 && isOsReloadTransaction(activeTransaction) {
+					reloadTransaction = activeTransaction
+				}
+				return true, errors.New(fmt.Sprintf("Instance with id `%d` is still running transaction `%s` of `%s`, retrying...", instanceId, activeTransaction.TransactionStatus.Name, activeTransaction.TransactionGroup.Name))
+			}
+
+			if reloadTransaction.Id == 0 {
+				return true, errors.New(fmt.Sprintf("OS reload of instance with id `%d` has not started yet, retrying...", instanceId))
+			}
+
+			lastTransaction, err := slvgs.GetLastTransaction(instanceId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get last transaction of instance with id `%d` due to `%s`, retrying...", instanceId, err.Error()))
+			}
+
+			if !isOsReloadTransaction(lastTransaction) || isOlderTransaction(lastTransaction, reloadTransaction) {
+				return true, errors.New(fmt.Sprintf("OS reload of instance with id `%d` has not completed yet, retrying...", instanceId))
+			}
+
+			if isFailedTransaction(lastTransaction) {
+				return false, errors.New(fmt.Sprintf("OS reload of instance with id `%d` ended with transaction status `%s`", instanceId, lastTransaction.TransactionStatus.Name))
+			}
+
+			if lastTransaction.TransactionStatus.Name != "COMPLETE" {
+				return true, errors.New(fmt.Sprintf("OS reload of instance with id `%d` has not completed yet, retrying...", instanceId))
+			}
+
+			powerState, err := slvgs.GetPowerState(instanceId)
+			if err != nil {
+				return true, errors.New(fmt.Sprintf("Failed to get power state of instance with id `%d` due to `%s`, retrying...", instanceId, err.Error()))
+			}
+
+			if powerState.KeyName != "RUNNING" {
+				return true, errors.New(fmt.Sprintf("Instance with id `%d` is not running yet after the OS reload, retrying...", instanceId))
+			}
+
+			return false, nil
+		})
+	timeService := clock.NewClock()
+	timeoutRetryStrategy := boshretry.NewTimeoutRetryStrategy(time.Duration(SL_RELOAD_OS_TIMEOUT)*time.Second, time.Duration(SL_RELOAD_OS_POLLING_INTERVAL)*time.Second, execStmtRetryable, timeService, boshlog.NewLogger(boshlog.LevelInfo))
+	err = timeoutRetryStrategy.Try()
+	if err != nil {
+		return errors.New(fmt.Sprintf("Failed to wait for OS reload of instance with id `%d` within `%d` seconds: %s", instanceId, SL_RELOAD_OS_TIMEOUT, err.Error()))
 	}
 
 	return nil
@@ -320,7 +393,12 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetPrimaryBackendIpAddress(instanc
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	objectMask := []string{
+		"transactionGroup",
+		"transactionStatus",
+	}
+
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getActiveTransaction.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
 	if err != nil {
 		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
 	}
@@ -342,6 +420,7 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetActiveTransaction(instanceId in
 func (slvgs *softLayer_Virtual_Guest_Service) GetLastTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
 	objectMask := []string{
 		"transactionGroup",
+		"transactionStatus",
 	}
 
 	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(fmt.Sprintf("%s/%d/getLastTransaction.json", slvgs.GetName(), instanceId), objectMask, "GET", new(bytes.Buffer))
@@ -697,94 +776,19 @@ func (slvgs *softLayer_Virtual_Guest_Service) AttachEphemeralDisk(instanceId int
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) UpgradeObject(instanceId int, options *softlayer.UpgradeOptions) (bool, error) {
-	prices, err := slvgs.GetAvailableUpgradeItemPrices(options)
-	if err != nil {
-		return false, err
-	}
-
-	if len(prices) == 0 {
-		// Nothing to order, as all the values are up to date
-		return false, nil
-	}
-
-	orderService, err := slvgs.client.GetSoftLayer_Product_Order_Service()
-	if err != nil {
-		return false, err
-	}
-
-	order := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
-		VirtualGuests: []datatypes.VirtualGuest{
-			datatypes.VirtualGuest{
-				Id: instanceId,
-			},
-		},
-		Prices:      prices,
-		ComplexType: UPGRADE_VIRTUAL_SERVER_ORDER_TYPE,
-		Properties: []datatypes.Property{
-			datatypes.Property{
-				Name:  MAINTENANCE_WINDOW_PROPERTY,
-				Value: time.Now().UTC().Format(time.RFC3339),
-			},
-		},
-	}
-
-	_, err = orderService.PlaceContainerOrderVirtualGuestUpgrade(order)
-	if err != nil {
-		return false, err
-	}
-
-	return true, nil
+	return slvgs.placeUpgradeOrder(instanceId, options, false)
 }
 
-func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPrices(upgradeOptions *softlayer.UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error) {
-	itemsCapacity := make(map[string]int)
-	if upgradeOptions.Cpus > 0 {
-		itemsCapacity["cpus"] = upgradeOptions.Cpus
-	}
-	if upgradeOptions.MemoryInGB > 0 {
-		itemsCapacity["memory"] = upgradeOptions.MemoryInGB
-	}
-	if upgradeOptions.NicSpeed > 0 {
-		itemsCapacity["nic_speed"] = upgradeOptions.NicSpeed
-	}
-
-	virtualServerPackageItems, err := slvgs.getVirtualServerItems()
-	if err != nil {
-		return []datatypes.SoftLayer_Product_Item_Price{}, err
-	}
-
-	prices := make([]datatypes.SoftLayer_Product_Item_Price, 0)
-
-	for item, amount := range itemsCapacity {
-		price, err := slvgs.filterProductItemPrice(virtualServerPackageItems, item, amount)
-		if err != nil {
-			return []datatypes.SoftLayer_Product_Item_Price{}, err
-		}
-
-		prices = append(prices, price)
-	}
+func (slvgs *softLayer_Virtual_Guest_Service) DowngradeObject(instanceId int, options *softlayer.UpgradeOptions) (bool, error) {
+	return slvgs.placeUpgradeOrder(instanceId, options, true)
+}
 
-	return prices, nil
+func (slvgs *softLayer_Virtual_Guest_Service) GetAvailableUpgradeItemPrices(instanceId int, upgradeOptions *softlayer.UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	return slvgs.findUpgradeItemPrices(instanceId, upgradeOptions, false)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error) {
-	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
-	if err != nil {
-		return []datatypes.SoftLayer_Product_Item_Price{}, err
-	}
-
-	if common.IsHttpErrorCode(errorCode) {
-		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getUpgradeItemPrices, HTTP error code: '%d'", errorCode)
-		return []datatypes.SoftLayer_Product_Item_Price{}, errors.New(errorMessage)
-	}
-
-	itemPrices := []datatypes.SoftLayer_Product_Item_Price{}
-	err = json.Unmarshal(response, &itemPrices)
-	if err != nil {
-		return []datatypes.SoftLayer_Product_Item_Price{}, err
-	}
-
-	return itemPrices, nil
+	return slvgs.getUpgradeItemPrices(instanceId, false)
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) SetTags(instanceId int, tags []string) (bool, error) {
@@ -1215,59 +1219,599 @@ func (slvgs *softLayer_Virtual_Guest_Service) GetBlockDevices(instanceId int) ([
 	return vgBlockDevices, nil
 }
 
+func (slvgs *softLayer_Virtual_Guest_Service) Pause(instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/pause.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#pause, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to pause instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	}
+
+	return true, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) Resume(instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/resume.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#resume, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to resume instance with id '%d', got '%s' as response from the API.", instanceId, res))
+	}
+
+	return true, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ExecuteRescueLayer(instanceId int) (bool, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/executeRescueLayer.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#executeRescueLayer, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to boot instance with id '%d' into the rescue layer, got '%s' as response from the API.", instanceId, res))
+	}
+
+	return true, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) Migrate(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/migrate.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#migrate, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, errors.New(errorMessage)
+	}
+
+	transaction := datatypes.SoftLayer_Provisioning_Version1_Transaction{}
+	err = json.Unmarshal(response, &transaction)
+	if err != nil {
+		return datatypes.SoftLayer_Provisioning_Version1_Transaction{}, err
+	}
+
+	return transaction, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) MigrateDedicatedHost(instanceId int, destinationHostId int) error {
+	parameters := datatypes.SoftLayer_Virtual_GuestInitParameters{
+		Parameters: []interface{}{destinationHostId},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return err
+	}
+
+	_, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/migrateDedicatedHost.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#migrateDedicatedHost, HTTP error code: '%d'", errorCode)
+		return errors.New(errorMessage)
+	}
+
+	return nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetConsoleData(instanceId int) (datatypes.SoftLayer_Container_Virtual_ConsoleData, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getConsoleData.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Container_Virtual_ConsoleData{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getConsoleData, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Container_Virtual_ConsoleData{}, errors.New(errorMessage)
+	}
+
+	consoleData := datatypes.SoftLayer_Container_Virtual_ConsoleData{}
+	err = json.Unmarshal(response, &consoleData)
+	if err != nil {
+		return datatypes.SoftLayer_Container_Virtual_ConsoleData{}, err
+	}
+
+	return consoleData, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetBootOrder(instanceId int) (string, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBootOrder.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return "", err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getBootOrder, HTTP error code: '%d'", errorCode)
+		return "", errors.New(errorMessage)
+	}
+
+	bootOrder := ""
+	err = json.Unmarshal(response, &bootOrder)
+	if err != nil {
+		return "", err
+	}
+
+	return bootOrder, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) SetBootOrder(instanceId int, bootOrder string) (bool, error) {
+	parameters := datatypes.SoftLayer_Virtual_GuestInitParameters{
+		Parameters: []interface{}{bootOrder},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return false, err
+	}
+
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/setBootOrder.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return false, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#setBootOrder, HTTP error code: '%d'", errorCode)
+		return false, errors.New(errorMessage)
+	}
+
+	if res := string(response[:]); res != "true" {
+		return false, errors.New(fmt.Sprintf("Failed to set boot order '%s' for instance with id '%d', got '%s' as response from the API.", bootOrder, instanceId, res))
+	}
+
+	return true, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetBandwidthDataByDate(instanceId int, startDate time.Time, endDate time.Time, networkType string) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error) {
+	parameters := []interface{}{startDate.Format(time.RFC3339), endDate.Format(time.RFC3339), networkType}
+	return slvgs.getMetricDataByDate(instanceId, "getBandwidthDataByDate", parameters)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetCpuMetricDataByDate(instanceId int, startDate time.Time, endDate time.Time, cpuIndexes []int) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error) {
+	parameters := []interface{}{startDate.Format(time.RFC3339), endDate.Format(time.RFC3339)}
+	if len(cpuIndexes) > 0 {
+		parameters = append(parameters, cpuIndexes)
+	}
+
+	return slvgs.getMetricDataByDate(instanceId, "getCpuMetricDataByDate", parameters)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetMemoryMetricDataByDate(instanceId int, startDate time.Time, endDate time.Time) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error) {
+	parameters := []interface{}{startDate.Format(time.RFC3339), endDate.Format(time.RFC3339)}
+	return slvgs.getMetricDataByDate(instanceId, "getMemoryMetricDataByDate", parameters)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetBillingCycleBandwidthUsage(instanceId int) ([]datatypes.SoftLayer_Network_Bandwidth_Usage, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBillingCycleBandwidthUsage.json", slvgs.GetName(), instanceId), "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Bandwidth_Usage{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getBillingCycleBandwidthUsage, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Network_Bandwidth_Usage{}, errors.New(errorMessage)
+	}
+
+	bandwidthUsages := []datatypes.SoftLayer_Network_Bandwidth_Usage{}
+	err = json.Unmarshal(response, &bandwidthUsages)
+	if err != nil {
+		return []datatypes.SoftLayer_Network_Bandwidth_Usage{}, err
+	}
+
+	return bandwidthUsages, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetInboundPublicBandwidthUsage(instanceId int) (float64, error) {
+	return slvgs.getBandwidthUsage(instanceId, "getInboundPublicBandwidthUsage")
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetOutboundPublicBandwidthUsage(instanceId int) (float64, error) {
+	return slvgs.getBandwidthUsage(instanceId, "getOutboundPublicBandwidthUsage")
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/getBillingItem.json", slvgs.GetName(), id), "GET", new(bytes.Buffer))
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getBillingItem, HTTP error code: '%d'", errorCode)
+		return datatypes.SoftLayer_Billing_Item{}, errors.New(errorMessage)
+	}
+
+	billingItem := datatypes.SoftLayer_Billing_Item{}
+	err = json.Unmarshal(response, &billingItem)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item{}, err
+	}
+
+	return billingItem, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) CancelObject(id int, options *softlayer.CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	billingItem, err := slvgs.findBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	cancellationRequestService, err := slvgs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return cancellationRequestService.CancelBillingItem(billingItem.Id, options)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) GetPendingCancellationRequest(id int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error) {
+	billingItem, err := slvgs.findBillingItem(id)
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	cancellationRequestService, err := slvgs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return datatypes.SoftLayer_Billing_Item_Cancellation_Request{}, err
+	}
+
+	return cancellationRequestService.GetPendingCancellationRequest(billingItem.Id)
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) ReverseCancellation(id int) (bool, error) {
+	request, err := slvgs.GetPendingCancellationRequest(id)
+	if err != nil {
+		return false, err
+	}
+
+	if request.Id == 0 {
+		return false, errors.New(fmt.Sprintf("No pending cancellation request found for virtual guest with id '%d'", id))
+	}
+
+	cancellationRequestService, err := slvgs.client.GetSoftLayer_Billing_Item_Cancellation_Request_Service()
+	if err != nil {
+		return false, err
+	}
+
+	return cancellationRequestService.Void(request.Id, true)
+}
+
 //Private methods
 
-func (slvgs *softLayer_Virtual_Guest_Service) getVirtualServerItems() ([]datatypes.SoftLayer_Product_Item, error) {
-	service, err := slvgs.client.GetSoftLayer_Product_Package_Service()
+func (slvgs *softLayer_Virtual_Guest_Service) findBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error) {
+	billingItem, err := slvgs.GetBillingItem(id)
 	if err != nil {
-		return []datatypes.SoftLayer_Product_Item{}, err
+		return datatypes.SoftLayer_Billing_Item{}, err
 	}
 
-	return service.GetItemsByType(VIRTUAL_SERVER_PACKAGE_TYPE)
+	if billingItem.Id == 0 {
+		return datatypes.SoftLayer_Billing_Item{}, errors.New(fmt.Sprintf("No billing item found for virtual guest with id '%d'", id))
+	}
+
+	return billingItem, nil
 }
 
-func (slvgs *softLayer_Virtual_Guest_Service) filterProductItemPrice(packageItems []datatypes.SoftLayer_Product_Item, option string, amount int) (datatypes.SoftLayer_Product_Item_Price, error) {
-	// for now use hardcoded values in the same "style" as Python client does
-	// refer to corresponding Python method #_get_item_id_for_upgrade: https://github.com/softlayer/softlayer-python/blob/master/SoftLayer/managers/vs.py
-	vsId := map[string]int{
-		"memory":    3,
-		"cpus":      80,
-		"nic_speed": 26,
+func (slvgs *softLayer_Virtual_Guest_Service) getMetricDataByDate(instanceId int, method string, parameters []interface{}) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error) {
+	requestBody, err := json.Marshal(datatypes.SoftLayer_Virtual_GuestInitParameters{Parameters: parameters})
+	if err != nil {
+		return []datatypes.SoftLayer_Metric_Tracking_Object_Data{}, err
 	}
 
-	for _, packageItem := range packageItems {
-		categories := packageItem.Prices[0].Categories
-		for _, category := range categories {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slvgs.GetName(), instanceId, method), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return []datatypes.SoftLayer_Metric_Tracking_Object_Data{}, err
+	}
 
-			if packageItem.Capacity == "" {
-				continue
-			}
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#%s, HTTP error code: '%d'", method, errorCode)
+		return []datatypes.SoftLayer_Metric_Tracking_Object_Data{}, errors.New(errorMessage)
+	}
 
-			capacity, err := strconv.Atoi(packageItem.Capacity)
-			if err != nil {
-				return datatypes.SoftLayer_Product_Item_Price{}, err
-			}
+	metricData := []datatypes.SoftLayer_Metric_Tracking_Object_Data{}
+	err = json.Unmarshal(response, &metricData)
+	if err != nil {
+		return []datatypes.SoftLayer_Metric_Tracking_Object_Data{}, err
+	}
+
+	return metricData, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) getBandwidthUsage(instanceId int, method string) (float64, error) {
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/%s.json", slvgs.GetName(), instanceId, method), "GET", new(bytes.Buffer))
+	if err != nil {
+		return 0, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#%s, HTTP error code: '%d'", method, errorCode)
+		return 0, errors.New(errorMessage)
+	}
+
+	res := strings.Trim(string(response[:]), `"`)
+	usage, err := strconv.ParseFloat(res, 64)
+	if err != nil {
+		return 0, errors.New(fmt.Sprintf("Failed to parse bandwidth usage of instance with id '%d', got '%s' as response from the API.", instanceId, string(response[:])))
+	}
+
+	return usage, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) reloadOperatingSystem(instanceId int, token string, template datatypes.Image_Template_Config) ([]byte, error) {
+	parameters := datatypes.SoftLayer_Virtual_GuestInitParameters{
+		Parameters: []interface{}{token, template},
+	}
+
+	requestBody, err := json.Marshal(parameters)
+	if err != nil {
+		return []byte{}, err
+	}
+
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequest(fmt.Sprintf("%s/%d/reloadOperatingSystem.json", slvgs.GetName(), instanceId), "POST", bytes.NewBuffer(requestBody))
+	if err != nil {
+		return []byte{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#reloadOperatingSystem, HTTP error code: '%d'", errorCode)
+		return []byte{}, errors.New(errorMessage)
+	}
+
+	return response, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) getUpgradeItemPrices(instanceId int, includeDowngradeItemPrices bool) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	path := fmt.Sprintf("%s/%d/getUpgradeItemPrices.json", slvgs.GetName(), instanceId)
+	if includeDowngradeItemPrices {
+		path = fmt.Sprintf("%s/%d/getUpgradeItemPrices/true.json", slvgs.GetName(), instanceId)
+	}
+
+	objectMask := []string{
+		"id",
+		"locationGroupId",
+		"categories.categoryCode",
+		"item.capacity",
+		"item.description",
+		"item.units",
+		"item.keyName",
+	}
+
+	response, errorCode, err := slvgs.client.GetHttpClient().DoRawHttpRequestWithObjectMask(path, objectMask, "GET", new(bytes.Buffer))
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	if common.IsHttpErrorCode(errorCode) {
+		errorMessage := fmt.Sprintf("softlayer-go: could not SoftLayer_Virtual_Guest#getUpgradeItemPrices, HTTP error code: '%d'", errorCode)
+		return []datatypes.SoftLayer_Product_Item_Price{}, errors.New(errorMessage)
+	}
+
+	itemPrices := []datatypes.SoftLayer_Product_Item_Price{}
+	err = json.Unmarshal(response, &itemPrices)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
 
-			if category.Id != vsId[option] || capacity != amount {
+	return itemPrices, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) placeUpgradeOrder(instanceId int, options *softlayer.UpgradeOptions, includeDowngradeItemPrices bool) (bool, error) {
+	prices, err := slvgs.findUpgradeItemPrices(instanceId, options, includeDowngradeItemPrices)
+	if err != nil {
+		return false, err
+	}
+
+	if len(prices) == 0 {
+		// Nothing to order, as all the values are up to date
+		return false, nil
+	}
+
+	orderService, err := slvgs.client.GetSoftLayer_Product_Order_Service()
+	if err != nil {
+		return false, err
+	}
+
+	maintenanceWindow := options.MaintenanceWindow
+	if maintenanceWindow.IsZero() {
+		maintenanceWindow = time.Now()
+	}
+
+	order := datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade{
+		VirtualGuests: []datatypes.VirtualGuest{
+			datatypes.VirtualGuest{
+				Id: instanceId,
+			},
+		},
+		Prices:      prices,
+		ComplexType: UPGRADE_VIRTUAL_SERVER_ORDER_TYPE,
+		Properties: []datatypes.Property{
+			datatypes.Property{
+				Name:  MAINTENANCE_WINDOW_PROPERTY,
+				Value: maintenanceWindow.UTC().Format(time.RFC3339),
+			},
+		},
+	}
+
+	_, err = orderService.PlaceContainerOrderVirtualGuestUpgrade(order)
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPrices(instanceId int, options *softlayer.UpgradeOptions, includeDowngradeItemPrices bool) ([]datatypes.SoftLayer_Product_Item_Price, error) {
+	upgradeOptions := []string{}
+	itemsCapacity := make(map[string]int)
+	if options.Cpus > 0 {
+		upgradeOptions = append(upgradeOptions, "cpus")
+		itemsCapacity["cpus"] = options.Cpus
+	}
+	if options.MemoryInGB > 0 {
+		upgradeOptions = append(upgradeOptions, "memory")
+		itemsCapacity["memory"] = options.MemoryInGB
+	}
+	if options.NicSpeed > 0 {
+		upgradeOptions = append(upgradeOptions, "nic_speed")
+		itemsCapacity["nic_speed"] = options.NicSpeed
+	}
+	if options.PublicBandwidth > 0 {
+		upgradeOptions = append(upgradeOptions, "bandwidth")
+		itemsCapacity["bandwidth"] = options.PublicBandwidth
+	}
+
+	prices := make([]datatypes.SoftLayer_Product_Item_Price, 0)
+	if len(upgradeOptions) == 0 && len(options.Disks) == 0 {
+		return prices, nil
+	}
+
+	itemPrices, err := slvgs.getUpgradeItemPrices(instanceId, includeDowngradeItemPrices)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	for _, option := range upgradeOptions {
+		price, err := slvgs.filterUpgradeItemPrice(itemPrices, option, itemsCapacity[option], options.DedicatedCores)
+		if err != nil {
+			return []datatypes.SoftLayer_Product_Item_Price{}, err
+		}
+
+		prices = append(prices, price)
+	}
+
+	if len(options.Disks) == 0 {
+		return prices, nil
+	}
+
+	localDiskFlag, err := slvgs.GetLocalDiskFlag(instanceId)
+	if err != nil {
+		return []datatypes.SoftLayer_Product_Item_Price{}, err
+	}
+
+	diskNumbers := []int{}
+	for diskNumber := range options.Disks {
+		diskNumbers = append(diskNumbers, diskNumber)
+	}
+	sort.Ints(diskNumbers)
+
+	for _, diskNumber := range diskNumbers {
+		price, err := slvgs.filterUpgradeDiskItemPrice(itemPrices, diskNumber, options.Disks[diskNumber], localDiskFlag)
+		if err != nil {
+			return []datatypes.SoftLayer_Product_Item_Price{}, err
+		}
+
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) filterUpgradeItemPrice(itemPrices []datatypes.SoftLayer_Product_Item_Price, option string, amount int, dedicatedCores bool) (datatypes.SoftLayer_Product_Item_Price, error) {
+	// refer to corresponding Python method #_get_price_id_for_upgrade_option: https://github.com/softlayer/softlayer-python/blob/master/SoftLayer/managers/vs.py
+	categoryCodes := map[string]string{
+		"memory":    "ram",
+		"cpus":      "guest_core",
+		"nic_speed": "port_speed",
+		"bandwidth": "bandwidth",
+	}
+
+	for _, itemPrice := range itemPrices {
+		if itemPrice.Item == nil || itemPrice.Item.Capacity == "" {
+			continue
+		}
+
+		capacity, err := strconv.Atoi(itemPrice.Item.Capacity)
+		if err != nil || capacity != amount {
+			continue
+		}
+
+		for _, category := range itemPrice.Categories {
+			if category.CategoryCode != categoryCodes[option] {
 				continue
 			}
 
 			switch option {
 			case "cpus":
-				if !strings.Contains(packageItem.Description, "Private") {
-					return packageItem.Prices[0], nil
+				if dedicatedCores != slvgs.isPrivateCoreItem(itemPrice.Item) {
+					continue
 				}
 			case "nic_speed":
-				if strings.Contains(packageItem.Description, "Public") {
-					return packageItem.Prices[0], nil
+				if !strings.Contains(itemPrice.Item.Description, "Public") {
+					continue
 				}
-			default:
-				return packageItem.Prices[0], nil
 			}
+
+			return slvgs.upgradeItemPriceForCategory(itemPrice, category.CategoryCode), nil
 		}
 	}
 
-	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find price for '%s' (of size %d)", option, amount))
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find upgrade price for '%s' (of size %d)", option, amount))
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) filterUpgradeDiskItemPrice(itemPrices []datatypes.SoftLayer_Product_Item_Price, diskNumber int, diskSize int, localDiskFlag bool) (datatypes.SoftLayer_Product_Item_Price, error) {
+	categoryCode := fmt.Sprintf("guest_disk%d", diskNumber)
+
+	diskType := "(SAN)"
+	if localDiskFlag {
+		diskType = "(LOCAL)"
+	}
+
+	for _, itemPrice := range itemPrices {
+		if itemPrice.Item == nil || !strings.Contains(itemPrice.Item.Description, diskType) {
+			continue
+		}
+
+		capacity, err := strconv.Atoi(itemPrice.Item.Capacity)
+		if err != nil || capacity != diskSize {
+			continue
+		}
+
+		for _, category := range itemPrice.Categories {
+			if category.CategoryCode == categoryCode {
+				return slvgs.upgradeItemPriceForCategory(itemPrice, categoryCode), nil
+			}
+		}
+	}
+
+	return datatypes.SoftLayer_Product_Item_Price{}, errors.New(fmt.Sprintf("Failed to find upgrade price for '%s' (of size %d)", categoryCode, diskSize))
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) isPrivateCoreItem(item *datatypes.Item) bool {
+	if item.Units == "PRIVATE_CORE" || item.Units == "DEDICATED_CORE" {
+		return true
+	}
+
+	return strings.Contains(item.Description, "Private") || strings.Contains(item.Description, "Dedicated")
+}
+
+func (slvgs *softLayer_Virtual_Guest_Service) upgradeItemPriceForCategory(itemPrice datatypes.SoftLayer_Product_Item_Price, categoryCode string) datatypes.SoftLayer_Product_Item_Price {
+	return datatypes.SoftLayer_Product_Item_Price{
+		Id: itemPrice.Id,
+		Categories: []datatypes.Category{
+			datatypes.Category{
+				CategoryCode: categoryCode,
+			},
+		},
+	}
 }
 
 func (slvgs *softLayer_Virtual_Guest_Service) checkCreateObjectRequiredValues(template datatypes.SoftLayer_Virtual_Guest_Template) error {
@@ -1358,3 +1902,19 @@ func (slvgs *softLayer_Virtual_Guest_Service) findUpgradeItemPriceForEphemeralDi
 
 	return currentItemPrice, nil
 }
+
+func isOsReloadTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) bool {
+	return strings.Contains(strings.ToUpper(transaction.TransactionGroup.Name), "RELOAD")
+}
+
+func isFailedTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction) bool {
+	return strings.Contains(strings.ToUpper(transaction.TransactionStatus.Name), "FAIL")
+}
+
+func isOlderTransaction(transaction datatypes.SoftLayer_Provisioning_Version1_Transaction, than datatypes.SoftLayer_Provisioning_Version1_Transaction) bool {
+	if transaction.CreateDate == nil || than.CreateDate == nil {
+		return transaction.Id < than.Id
+	}
+
+	return transaction.CreateDate.Before(*than.CreateDate)
+}
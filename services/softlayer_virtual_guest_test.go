@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"os"
+	"time"
 
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
@@ -96,6 +97,19 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(virtualGuest.DedicatedAccountHostOnlyFlag).To(BeFalse())
 		})
 
+		It("sends the security groups to bind to the network components", func() {
+			virtualGuestTemplate.PrimaryNetworkComponent = &datatypes.PrimaryNetworkComponent{
+				NetworkVlan: datatypes.NetworkVlan{Id: 1122},
+				SecurityGroupBindings: []datatypes.SecurityGroupBinding{
+					datatypes.SecurityGroupBinding{SecurityGroup: datatypes.SecurityGroup{Id: 4455}},
+				},
+			}
+
+			_, err = virtualGuestService.CreateObject(virtualGuestTemplate)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"primaryNetworkComponent":{"networkVlan":{"id":1122},"securityGroupBindings":[{"securityGroup":{"id":4455}}]}`))
+		})
+
 		It("flags all missing required parameters for SoftLayer_Virtual_Guest/createObject.json POST call", func() {
 			virtualGuestTemplate = datatypes.SoftLayer_Virtual_Guest_Template{}
 			_, err := virtualGuestService.CreateObject(virtualGuestTemplate)
@@ -278,11 +292,28 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 
-		It("fails to reload OS on the virtual guest instance", func() {
+		It("accepts any successful response from the API", func() {
 			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"99"`)
 
 			err = virtualGuestService.ReloadOperatingSystem(virtualGuest.Id, reload_OS_Config)
-			Expect(err).To(HaveOccurred())
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("forces the reload with the given configuration", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+			reload_OS_Config = datatypes.Image_Template_Config{
+				OperatingSystemReferenceCode: "UBUNTU_14_64",
+				SshKeyIds:                    []int{123, 456},
+				CustomProvisionScriptUri:     "https://example.com/provision.sh",
+				KeepDataDisks:                true,
+				LockboxFlag:                  true,
+			}
+
+			err = virtualGuestService.ReloadOperatingSystem(virtualGuest.Id, reload_OS_Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/reloadOperatingSystem.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["FORCE",{"operatingSystemReferenceCode":"UBUNTU_14_64","sshKeyIds":[123,456],"customProvisionScriptUri":"https://example.com/provision.sh","keepDataDisks":true,"lockboxFlag":true}]}`))
 		})
 
 		Context("when HTTP client returns error codes 40x or 50x", func() {
@@ -306,6 +337,181 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 	})
 
+	Context("#GetReloadOperatingSystemToken", func() {
+		BeforeEach(func() {
+			reload_OS_Config = datatypes.Image_Template_Config{
+				ImageTemplateId: "5b7bc66a-72c6-447a-94a1-967803fcd76b",
+			}
+			virtualGuest.Id = 1234567
+		})
+
+		It("returns the confirmation token without reloading the OS", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"a1b2c3d4e5f6"`)
+
+			token, err := virtualGuestService.GetReloadOperatingSystemToken(virtualGuest.Id, reload_OS_Config)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(token).To(Equal("a1b2c3d4e5f6"))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["",{"imageTemplateId":"5b7bc66a-72c6-447a-94a1-967803fcd76b"}]}`))
+		})
+
+		It("fails when the API does not return a token", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`""`)
+
+			_, err := virtualGuestService.GetReloadOperatingSystemToken(virtualGuest.Id, reload_OS_Config)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"a1b2c3d4e5f6"`)
+
+					_, err := virtualGuestService.GetReloadOperatingSystemToken(virtualGuest.Id, reload_OS_Config)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"a1b2c3d4e5f6"`)
+
+					_, err := virtualGuestService.GetReloadOperatingSystemToken(virtualGuest.Id, reload_OS_Config)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ConfirmReloadOperatingSystem", func() {
+		BeforeEach(func() {
+			reload_OS_Config = datatypes.Image_Template_Config{
+				ImageTemplateId: "5b7bc66a-72c6-447a-94a1-967803fcd76b",
+			}
+			virtualGuest.Id = 1234567
+		})
+
+		It("reloads the OS using the confirmation token", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1"`)
+
+			err = virtualGuestService.ConfirmReloadOperatingSystem(virtualGuest.Id, "a1b2c3d4e5f6", reload_OS_Config)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["a1b2c3d4e5f6",{"imageTemplateId":"5b7bc66a-72c6-447a-94a1-967803fcd76b"}]}`))
+		})
+
+		It("fails to reload the OS when the token is rejected", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestInt = 500
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`{"error":"Invalid token.","code":"SoftLayer_Exception_Public"}`)
+
+			err = virtualGuestService.ConfirmReloadOperatingSystem(virtualGuest.Id, "expired-token", reload_OS_Config)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("#WaitForReloadOperatingSystem", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			os.Setenv("SL_RELOAD_OS_TIMEOUT", "3")
+			os.Setenv("SL_RELOAD_OS_POLLING_INTERVAL", "1")
+		})
+
+		AfterEach(func() {
+			os.Setenv("SL_RELOAD_OS_TIMEOUT", "")
+			os.Setenv("SL_RELOAD_OS_POLLING_INTERVAL", "")
+		})
+
+		It("waits until the reload transaction has completed and the instance is running", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json",
+				"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json",
+			})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Virtual_Guest_Service_getLastTransaction_reload.json",
+				"SoftLayer_Virtual_Guest_Service_getPowerState.json",
+			})
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(5))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(ContainElement("transactionGroup"))
+		})
+
+		It("keeps waiting until an OS reload transaction has been seen", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{[]byte("null")}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Virtual_Guest_Service_getLastTransaction_reload.json",
+				"SoftLayer_Virtual_Guest_Service_getPowerState.json",
+			})
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(5))
+		})
+
+		It("keeps waiting while the last transaction is not an OS reload", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getLastTransaction.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Virtual_Guest_Service_getLastTransaction_reload.json",
+				"SoftLayer_Virtual_Guest_Service_getPowerState.json",
+			})
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(6))
+		})
+
+		It("keeps waiting until the instance is running", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getLastTransaction_reload.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte(`{"keyName":"HALTED","name":"Halted"}`), []byte("null"))
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{
+				"SoftLayer_Virtual_Guest_Service_getLastTransaction_reload.json",
+				"SoftLayer_Virtual_Guest_Service_getPowerState.json",
+			})
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(7))
+		})
+
+		It("fails when the OS reload transaction ends in a failed status", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json"})
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses,
+				[]byte("null"),
+				[]byte(`{"id":11878021,"createDate":"2016-10-19T11:09:52-05:00","transactionGroup":{"name":"Cloud Instance OS Reload"},"transactionStatus":{"name":"RELOAD_FAILED"}}`),
+			)
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("RELOAD_FAILED"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(3))
+		})
+
+		It("fails when the reload transaction never completes", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getActiveTransaction_reload.json")
+			Expect(err).ToNot(HaveOccurred())
+
+			err = virtualGuestService.WaitForReloadOperatingSystem(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
 	Context("#DeleteObject", func() {
 		BeforeEach(func() {
 			virtualGuest.Id = 1234567
@@ -430,20 +636,108 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 
 	Context("#UpgradeObject", func() {
 		BeforeEach(func() {
-			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
-			Expect(err).ToNot(HaveOccurred())
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json",
+				"SoftLayer_Product_Order_placeOrder.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
 		})
 
 		It("can upgrade object without any error", func() {
-			_, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
+			upgraded, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
 				Cpus:       2,
 				MemoryInGB: 2,
 				NicSpeed:   1000,
 			})
 			Expect(err).ToNot(HaveOccurred())
+			Expect(upgraded).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Product_Order/placeOrder.json"))
+
+			requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+			Expect(requestBody).To(ContainSubstring(`{"id":1640,"locationGroupId":0,"categories":[{"id":0,"categoryCode":"guest_core"}]}`))
+			Expect(requestBody).To(ContainSubstring(`{"id":1644,"locationGroupId":0,"categories":[{"id":0,"categoryCode":"ram"}]}`))
+			Expect(requestBody).To(ContainSubstring(`{"id":274,"locationGroupId":0,"categories":[{"id":0,"categoryCode":"port_speed"}]}`))
+		})
+
+		It("upgrades to dedicated cores and public bandwidth", func() {
+			upgraded, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
+				Cpus:            2,
+				DedicatedCores:  true,
+				PublicBandwidth: 1000,
+			})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upgraded).To(BeTrue())
+
+			requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+			Expect(requestBody).To(ContainSubstring(`"id":1641`))
+			Expect(requestBody).To(ContainSubstring(`"id":1800`))
+			Expect(requestBody).ToNot(ContainSubstring(`"id":1640`))
+		})
+
+		It("places the order in the requested maintenance window", func() {
+			maintenanceWindow := time.Date(2016, time.March, 1, 4, 30, 0, 0, time.UTC)
+			_, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
+				MemoryInGB:        4,
+				MaintenanceWindow: maintenanceWindow,
+			})
+			Expect(err).ToNot(HaveOccurred())
+
+			requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+			Expect(requestBody).To(ContainSubstring(`{"name":"MAINTENANCE_WINDOW","value":"2016-03-01T04:30:00Z"}`))
+		})
+
+		It("reports error when upgrade price for provided bandwidth is not available", func() {
+			_, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{PublicBandwidth: 5000})
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(Equal("Failed to find upgrade price for 'bandwidth' (of size 5000)"))
+		})
+
+		It("does not place an order when there is nothing to upgrade", func() {
+			upgraded, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(upgraded).To(BeFalse())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(0))
+		})
+
+		Context("when upgrading disks", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fileNames := []string{
+					"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json",
+					"SoftLayer_Virtual_Guest_Service_getLocalDiskFlag_san.json",
+					"SoftLayer_Product_Order_placeOrder.json",
+				}
+				testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			})
+
+			It("orders disks matching the disk type of the virtual guest", func() {
+				upgraded, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
+					Disks: map[int]int{1: 100, 2: 100},
+				})
+				Expect(err).ToNot(HaveOccurred())
+				Expect(upgraded).To(BeTrue())
+
+				requestBody := fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()
+				Expect(requestBody).To(ContainSubstring(`{"id":2255,"locationGroupId":0,"categories":[{"id":0,"categoryCode":"guest_disk1"}]}`))
+				Expect(requestBody).To(ContainSubstring(`{"id":2256,"locationGroupId":0,"categories":[{"id":0,"categoryCode":"guest_disk2"}]}`))
+			})
+
+			It("reports error when no disk price matches", func() {
+				_, err := virtualGuestService.UpgradeObject(123, &softlayer.UpgradeOptions{
+					Disks: map[int]int{3: 100},
+				})
+				Expect(err).To(HaveOccurred())
+				Expect(err.Error()).To(Equal("Failed to find upgrade price for 'guest_disk3' (of size 100)"))
+			})
 		})
 
 		Context("when HTTP client returns error codes 40x or 50x", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
 				for _, errorCode := range errorCodes {
@@ -472,28 +766,98 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 		})
 	})
 
+	Context("#DowngradeObject", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json",
+				"SoftLayer_Product_Order_placeOrder.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("includes downgrade item prices when resolving the order", func() {
+			downgraded, err := virtualGuestService.DowngradeObject(123, &softlayer.UpgradeOptions{MemoryInGB: 2})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(downgraded).To(BeTrue())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"id":1644`))
+		})
+
+		It("requests item prices including downgrades", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, []string{"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json"})
+
+			_, err := virtualGuestService.DowngradeObject(123, &softlayer.UpgradeOptions{MemoryInGB: 8})
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Virtual_Guest/123/getUpgradeItemPrices/true.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			BeforeEach(func() {
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+				fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json")
+				Expect(err).ToNot(HaveOccurred())
+			})
+
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					_, err := virtualGuestService.DowngradeObject(123, &softlayer.UpgradeOptions{MemoryInGB: 2})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					_, err := virtualGuestService.DowngradeObject(123, &softlayer.UpgradeOptions{MemoryInGB: 2})
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
 	Context("#GetAvailableUpgradeItemPrices", func() {
 		BeforeEach(func() {
-			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Product_Order_placeOrder.json")
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getUpgradeItemPrices_options.json",
+				"SoftLayer_Virtual_Guest_Service_getLocalDiskFlag_san.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("returns the prices UpgradeObject would order", func() {
+			prices, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{
+				Cpus:            2,
+				DedicatedCores:  true,
+				PublicBandwidth: 1000,
+				Disks:           map[int]int{1: 100},
+			})
 			Expect(err).ToNot(HaveOccurred())
+			Expect(len(prices)).To(Equal(3))
+			Expect(prices[0].Id).To(Equal(1641))
+			Expect(prices[1].Id).To(Equal(1800))
+			Expect(prices[2].Id).To(Equal(2255))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
 		})
 
 		It("reports error when pricing item for provided CPUs is not available", func() {
-			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(&softlayer.UpgradeOptions{Cpus: 3})
+			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{Cpus: 3})
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("Failed to find price for 'cpus' (of size 3)"))
+			Expect(err.Error()).To(Equal("Failed to find upgrade price for 'cpus' (of size 3)"))
 		})
 
 		It("reports error when pricing item for provided RAM is not available", func() {
-			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(&softlayer.UpgradeOptions{MemoryInGB: 1500})
+			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{MemoryInGB: 1500})
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("Failed to find price for 'memory' (of size 1500)"))
+			Expect(err.Error()).To(Equal("Failed to find upgrade price for 'memory' (of size 1500)"))
 		})
 
 		It("reports error when pricing item for provided network speed is not available", func() {
-			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(&softlayer.UpgradeOptions{NicSpeed: 999})
+			_, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{NicSpeed: 999})
 			Expect(err).To(HaveOccurred())
-			Expect(err.Error()).To(Equal("Failed to find price for 'nic_speed' (of size 999)"))
+			Expect(err.Error()).To(Equal("Failed to find upgrade price for 'nic_speed' (of size 999)"))
 		})
 
 		Context("when HTTP client returns error codes 40x or 50x", func() {
@@ -501,7 +865,8 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 				errorCodes := []int{400, 401, 499}
 				for _, errorCode := range errorCodes {
 					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
-					_, err := virtualGuestService.GetAvailableUpgradeItemPrices(&softlayer.UpgradeOptions{NicSpeed: 999})
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					_, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{NicSpeed: 1000})
 					Expect(err).To(HaveOccurred())
 				}
 			})
@@ -510,7 +875,8 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 				errorCodes := []int{500, 501, 599}
 				for _, errorCode := range errorCodes {
 					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
-					_, err := virtualGuestService.GetAvailableUpgradeItemPrices(&softlayer.UpgradeOptions{NicSpeed: 999})
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponsesIndex = 0
+					_, err := virtualGuestService.GetAvailableUpgradeItemPrices(123, &softlayer.UpgradeOptions{NicSpeed: 1000})
 					Expect(err).To(HaveOccurred())
 				}
 			})
@@ -1352,6 +1718,22 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			Expect(itemPrices[0].Categories[0].CategoryCode).To(Equal("guest_disk1"))
 		})
 
+		It("masks the categories and item of the upgrade item prices", func() {
+			_, err := virtualGuestService.GetUpgradeItemPrices(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getUpgradeItemPrices.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestWithObjectMaskMasks).To(Equal([]string{
+				"id",
+				"locationGroupId",
+				"categories.categoryCode",
+				"item.capacity",
+				"item.description",
+				"item.units",
+				"item.keyName",
+			}))
+		})
+
 		Context("when HTTP client returns error codes 40x or 50x", func() {
 			It("fails for error code 40x", func() {
 				errorCodes := []int{400, 401, 499}
@@ -2270,4 +2652,722 @@ var _ = Describe("SoftLayer_Virtual_Guest_Service", func() {
 			})
 		})
 	})
+
+	Context("#Pause", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("sucessfully pauses virtual guest instance", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			result, err := virtualGuestService.Pause(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			result, err := virtualGuestService.Pause(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.Pause(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.Pause(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Resume", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("sucessfully resumes virtual guest instance", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			result, err := virtualGuestService.Resume(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			result, err := virtualGuestService.Resume(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.Resume(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.Resume(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#ExecuteRescueLayer", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("sucessfully boots virtual guest instance into the rescue layer", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			result, err := virtualGuestService.ExecuteRescueLayer(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(result).To(BeTrue())
+		})
+
+		It("fails when the API does not return true", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			result, err := virtualGuestService.ExecuteRescueLayer(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+			Expect(result).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.ExecuteRescueLayer(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.ExecuteRescueLayer(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#Migrate", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_migrate.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sucessfully migrates virtual guest instance to another host", func() {
+			transaction, err := virtualGuestService.Migrate(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/migrate.json"))
+			Expect(transaction.GuestId).To(Equal(virtualGuest.Id))
+			Expect(transaction.Id).To(Equal(12476500))
+			Expect(transaction.TransactionStatus.Name).To(Equal("CLOUD_MIGRATE"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.Migrate(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.Migrate(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#MigrateDedicatedHost", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("null")
+		})
+
+		It("sucessfully migrates virtual guest instance to the destination dedicated host", func() {
+			err := virtualGuestService.MigrateDedicatedHost(virtualGuest.Id, 4321)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/migrateDedicatedHost.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":[4321]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					err := virtualGuestService.MigrateDedicatedHost(virtualGuest.Id, 4321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					err := virtualGuestService.MigrateDedicatedHost(virtualGuest.Id, 4321)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetConsoleData", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getConsoleData.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("sucessfully retrieves the console access data of virtual guest instance", func() {
+			consoleData, err := virtualGuestService.GetConsoleData(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(consoleData.Password).To(Equal("aBcD1234"))
+			Expect(consoleData.Port).To(Equal(5901))
+			Expect(consoleData.Uri).To(Equal("vnc://10.0.0.15:5901"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetConsoleData(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetConsoleData(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetBootOrder", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"hcd"`)
+		})
+
+		It("sucessfully retrieves the boot order of virtual guest instance", func() {
+			bootOrder, err := virtualGuestService.GetBootOrder(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(bootOrder).To(Equal("hcd"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBootOrder(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBootOrder(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#SetBootOrder", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("sucessfully sets the boot order of virtual guest instance", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+			set, err := virtualGuestService.SetBootOrder(virtualGuest.Id, "cdh")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(set).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/setBootOrder.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["cdh"]}`))
+		})
+
+		It("fails to set the boot order of virtual guest instance", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("false")
+
+			set, err := virtualGuestService.SetBootOrder(virtualGuest.Id, "cdh")
+			Expect(err).To(HaveOccurred())
+			Expect(set).To(BeFalse())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.SetBootOrder(virtualGuest.Id, "cdh")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("true")
+
+					_, err := virtualGuestService.SetBootOrder(virtualGuest.Id, "cdh")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetBandwidthDataByDate", func() {
+		var startDate, endDate time.Time
+
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			startDate = time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)
+			endDate = time.Date(2016, time.March, 2, 0, 0, 0, 0, time.UTC)
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getBandwidthDataByDate.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the bandwidth time series of virtual guest instance", func() {
+			bandwidthData, err := virtualGuestService.GetBandwidthDataByDate(virtualGuest.Id, startDate, endDate, "public")
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getBandwidthDataByDate.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["2016-03-01T00:00:00Z","2016-03-02T00:00:00Z","public"]}`))
+
+			Expect(len(bandwidthData)).To(Equal(4))
+			Expect(bandwidthData[0].Counter).To(Equal(1278.62))
+			Expect(bandwidthData[0].Type).To(Equal("publicIn_net_octet"))
+			Expect(bandwidthData[0].DateTime).ToNot(BeNil())
+			Expect(bandwidthData[3].Counter).To(Equal(float64(2980)))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBandwidthDataByDate(virtualGuest.Id, startDate, endDate, "public")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBandwidthDataByDate(virtualGuest.Id, startDate, endDate, "public")
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetCpuMetricDataByDate", func() {
+		var startDate, endDate time.Time
+
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			startDate = time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)
+			endDate = time.Date(2016, time.March, 2, 0, 0, 0, 0, time.UTC)
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getCpuMetricDataByDate.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the CPU time series of the given CPUs", func() {
+			cpuData, err := virtualGuestService.GetCpuMetricDataByDate(virtualGuest.Id, startDate, endDate, []int{0, 1})
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["2016-03-01T00:00:00Z","2016-03-02T00:00:00Z",[0,1]]}`))
+
+			Expect(len(cpuData)).To(Equal(2))
+			Expect(cpuData[1].Counter).To(Equal(7.25))
+			Expect(cpuData[1].Type).To(Equal("cpu1"))
+		})
+
+		It("omits the CPU indexes when none are given", func() {
+			_, err := virtualGuestService.GetCpuMetricDataByDate(virtualGuest.Id, startDate, endDate, nil)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(Equal(`{"parameters":["2016-03-01T00:00:00Z","2016-03-02T00:00:00Z"]}`))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetCpuMetricDataByDate(virtualGuest.Id, startDate, endDate, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetCpuMetricDataByDate(virtualGuest.Id, startDate, endDate, nil)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetMemoryMetricDataByDate", func() {
+		var startDate, endDate time.Time
+
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			startDate = time.Date(2016, time.March, 1, 0, 0, 0, 0, time.UTC)
+			endDate = time.Date(2016, time.March, 2, 0, 0, 0, 0, time.UTC)
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getMemoryMetricDataByDate.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the memory time series of virtual guest instance", func() {
+			memoryData, err := virtualGuestService.GetMemoryMetricDataByDate(virtualGuest.Id, startDate, endDate)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getMemoryMetricDataByDate.json"))
+			Expect(len(memoryData)).To(Equal(2))
+			Expect(memoryData[0].Counter).To(Equal(float64(1073741824)))
+			Expect(memoryData[0].Type).To(Equal("memory_usage"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetMemoryMetricDataByDate(virtualGuest.Id, startDate, endDate)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetMemoryMetricDataByDate(virtualGuest.Id, startDate, endDate)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetBillingCycleBandwidthUsage", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getBillingCycleBandwidthUsage.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the bandwidth usage of the current billing cycle", func() {
+			usages, err := virtualGuestService.GetBillingCycleBandwidthUsage(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+
+			Expect(len(usages)).To(Equal(2))
+			Expect(usages[0].AmountIn).To(Equal(".12345"))
+			Expect(usages[0].AmountOut).To(Equal("1.5"))
+			Expect(usages[0].Type).To(Equal("PUBLIC"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBillingCycleBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBillingCycleBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetInboundPublicBandwidthUsage", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("returns the inbound public bandwidth usage", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`".12345"`)
+
+			usage, err := virtualGuestService.GetInboundPublicBandwidthUsage(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(0.12345))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getInboundPublicBandwidthUsage.json"))
+		})
+
+		It("fails when the API does not return a number", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"fake-usage"`)
+
+			_, err := virtualGuestService.GetInboundPublicBandwidthUsage(virtualGuest.Id)
+			Expect(err).To(HaveOccurred())
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1.5"`)
+
+					_, err := virtualGuestService.GetInboundPublicBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1.5"`)
+
+					_, err := virtualGuestService.GetInboundPublicBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetOutboundPublicBandwidthUsage", func() {
+		BeforeEach(func() {
+			virtualGuest.Id = 1234567
+		})
+
+		It("returns the outbound public bandwidth usage", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("2.5")
+
+			usage, err := virtualGuestService.GetOutboundPublicBandwidthUsage(virtualGuest.Id)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(usage).To(Equal(2.5))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getOutboundPublicBandwidthUsage.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1.5"`)
+
+					_, err := virtualGuestService.GetOutboundPublicBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+					fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte(`"1.5"`)
+
+					_, err := virtualGuestService.GetOutboundPublicBandwidthUsage(virtualGuest.Id)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#GetBillingItem", func() {
+		BeforeEach(func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse, err = testhelpers.ReadJsonTestFixtures("services", "SoftLayer_Virtual_Guest_Service_getBillingItem.json")
+			Expect(err).ToNot(HaveOccurred())
+		})
+
+		It("returns the billing item of the virtual guest", func() {
+			billingItem, err := virtualGuestService.GetBillingItem(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(billingItem.Id).To(Equal(1234))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Virtual_Guest/1234567/getBillingItem.json"))
+		})
+
+		Context("when HTTP client returns error codes 40x or 50x", func() {
+			It("fails for error code 40x", func() {
+				errorCodes := []int{400, 401, 499}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBillingItem(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+
+			It("fails for error code 50x", func() {
+				errorCodes := []int{500, 501, 599}
+				for _, errorCode := range errorCodes {
+					fakeClient.FakeHttpClient.DoRawHttpRequestInt = errorCode
+
+					_, err := virtualGuestService.GetBillingItem(1234567)
+					Expect(err).To(HaveOccurred())
+				}
+			})
+		})
+	})
+
+	Context("#CancelObject", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_createObject.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("creates a cancellation request for the billing item of the virtual guest", func() {
+			request, err := virtualGuestService.CancelObject(1234567, &softlayer.CancellationOptions{Immediate: true, Notes: "fake-notes"})
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/createObject.json"))
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestRequestBody.String()).To(ContainSubstring(`"notes":"fake-notes","items":[{"billingItemId":1234,"immediateCancellationFlag":true}]`))
+		})
+
+		It("fails when the virtual guest has no billing item", func() {
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = [][]byte{}
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponse = []byte("{}")
+
+			_, err := virtualGuestService.CancelObject(1234567, nil)
+			Expect(err).To(HaveOccurred())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(1))
+		})
+	})
+
+	Context("#GetPendingCancellationRequest", func() {
+		BeforeEach(func() {
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+		})
+
+		It("returns the pending cancellation request of the virtual guest", func() {
+			request, err := virtualGuestService.GetPendingCancellationRequest(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(request.Id).To(Equal(123))
+			Expect(request.Status.KeyName).To(Equal("PENDING"))
+		})
+	})
+
+	Context("#ReverseCancellation", func() {
+		It("voids the pending cancellation request of the virtual guest", func() {
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+			fakeClient.FakeHttpClient.DoRawHttpRequestResponses = append(fakeClient.FakeHttpClient.DoRawHttpRequestResponses, []byte("true"))
+
+			reversed, err := virtualGuestService.ReverseCancellation(1234567)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(reversed).To(BeTrue())
+
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestPath).To(Equal("SoftLayer_Billing_Item_Cancellation_Request/123/void.json"))
+		})
+
+		It("fails when no cancellation is pending", func() {
+			fileNames := []string{
+				"SoftLayer_Virtual_Guest_Service_getBillingItem.json",
+				"SoftLayer_Billing_Item_Cancellation_Request_Service_getAllCancellationRequests_none_pending.json",
+			}
+			testhelpers.SetTestFixturesForFakeSoftLayerClient(fakeClient, fileNames)
+
+			reversed, err := virtualGuestService.ReverseCancellation(1234567)
+			Expect(err).To(HaveOccurred())
+			Expect(reversed).To(BeFalse())
+			Expect(fakeClient.FakeHttpClient.DoRawHttpRequestResponsesCount).To(Equal(2))
+		})
+	})
 })
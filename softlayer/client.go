@@ -21,6 +21,29 @@ type Client interface {
 	GetSoftLayer_Hardware_Service() (SoftLayer_Hardware_Service, error)
 	GetSoftLayer_Dns_Domain_Service() (SoftLayer_Dns_Domain_Service, error)
 	GetSoftLayer_Dns_Domain_ResourceRecord_Service() (SoftLayer_Dns_Domain_ResourceRecord_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_AType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_AaaaType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_CnameType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_MxType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_NsType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_PtrType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_SpfType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_TxtType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Dns_Domain_ResourceRecord_SrvType_Service() (SoftLayer_Dns_Domain_ResourceRecord_Type_Service, error)
+	GetSoftLayer_Network_Component_Service() (SoftLayer_Network_Component_Service, error)
+	GetSoftLayer_Network_Vlan_Service() (SoftLayer_Network_Vlan_Service, error)
+	GetSoftLayer_Network_Subnet_Service() (SoftLayer_Network_Subnet_Service, error)
+	GetSoftLayer_Network_Subnet_IpAddress_Service() (SoftLayer_Network_Subnet_IpAddress_Service, error)
+	GetSoftLayer_Network_Subnet_IpAddress_Global_Service() (SoftLayer_Network_Subnet_IpAddress_Global_Service, error)
+	GetSoftLayer_Network_SecurityGroup_Service() (SoftLayer_Network_SecurityGroup_Service, error)
+	GetSoftLayer_Network_Firewall_Update_Request_Service() (SoftLayer_Network_Firewall_Update_Request_Service, error)
+	GetSoftLayer_Network_Component_Firewall_Service() (SoftLayer_Network_Component_Firewall_Service, error)
+	GetSoftLayer_Network_Vlan_Firewall_Service() (SoftLayer_Network_Vlan_Firewall_Service, error)
+	GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service() (SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service, error)
+	GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service() (SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service, error)
+	GetSoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service() (SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service, error)
+	GetSoftLayer_Network_LoadBalancer_Global_Account_Service() (SoftLayer_Network_LoadBalancer_Global_Account_Service, error)
+	GetSoftLayer_Dns_Secondary_Service() (SoftLayer_Dns_Secondary_Service, error)
 
 	GetHttpClient() HttpClient
 }
@@ -20,5 +20,13 @@ type SoftLayer_Account_Service interface {
 	GetBlockDeviceTemplateGroupsWithFilter(filters string) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device_Template_Group, error)
 	GetDatacentersWithSubnetAllocations() ([]datatypes.SoftLayer_Location, error)
 	GetHardware() ([]datatypes.SoftLayer_Hardware, error)
+	GetHardwareWithFilter(filter string) ([]datatypes.SoftLayer_Hardware, error)
 	GetDomains() ([]datatypes.SoftLayer_Dns_Domain, error)
+	GetSecondaryDomains() ([]datatypes.SoftLayer_Dns_Secondary, error)
+	GetNetworkVlans() ([]datatypes.SoftLayer_Network_Vlan, error)
+	GetNetworkVlansWithFilter(filter string) ([]datatypes.SoftLayer_Network_Vlan, error)
+	GetNetworkVlansByDatacenterAndRouter(datacenter string, routerHostname string) ([]datatypes.SoftLayer_Network_Vlan, error)
+	GetGlobalIpRecords() ([]datatypes.SoftLayer_Network_Subnet_IpAddress_Global, error)
+	GetSubnets() ([]datatypes.SoftLayer_Network_Subnet, error)
+	GetSubnetsWithFilter(filter string) ([]datatypes.SoftLayer_Network_Subnet, error)
 }
@@ -4,8 +4,21 @@ import (
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
+type CancellationOptions struct {
+	Immediate bool   // Cancel right away instead of on the next anniversary date
+	ReasonId  int    // Id of the SoftLayer_Billing_Item_Cancellation_Reason
+	Notes     string // Customer notes attached to the cancellation request
+}
+
 type SoftLayer_Billing_Item_Cancellation_Request_Service interface {
 	Service
 
+	CancelBillingItem(billingItemId int, options *CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 	CreateObject(request datatypes.SoftLayer_Billing_Item_Cancellation_Request) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+
+	GetAllCancellationRequestsWithFilter(filter string) ([]datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+	GetObject(id int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+	GetPendingCancellationRequest(billingItemId int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+
+	Void(id int, closeRelatedTicket bool) (bool, error)
 }
@@ -0,0 +1,18 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// Implemented once for each of the SoftLayer_Dns_Domain_ResourceRecord_*Type services. Records are
+// validated client side for the type of the service before they are sent to the API.
+type SoftLayer_Dns_Domain_ResourceRecord_Type_Service interface {
+	Service
+
+	GetRecordType() string
+
+	CreateObject(template datatypes.SoftLayer_Dns_Domain_ResourceRecord_Template) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	GetObject(recordId int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	DeleteObject(recordId int) (bool, error)
+	EditObject(recordId int, template datatypes.SoftLayer_Dns_Domain_ResourceRecord) (bool, error)
+}
@@ -4,13 +4,61 @@ import (
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
+// Empty fields are not filtered on.
+type DnsResourceRecordFilter struct {
+	Host string
+	Type string
+	Data string
+}
+
+type DnsRecordUpdate struct {
+	Current datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Desired datatypes.SoftLayer_Dns_Domain_ResourceRecord
+}
+
+// Records are matched on their host, type and data. Matching records with a different TTL or priority are
+// updated in place, while a changed host, type or data results in a delete and a create.
+type DnsRecordSyncPlan struct {
+	DomainId   int
+	DomainName string
+
+	Creates []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Updates []DnsRecordUpdate
+	Deletes []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+}
+
+type DnsRecordSyncFailure struct {
+	Action string
+	Record datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Error  error
+}
+
+type DnsRecordSyncResult struct {
+	DryRun bool
+
+	Created  []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Updated  []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Deleted  []datatypes.SoftLayer_Dns_Domain_ResourceRecord
+	Failures []DnsRecordSyncFailure
+}
+
 // Modifying existing SoftLayer_Dns_Domain entries is not possible. Changes to zone names should be refactored to creation of new zones.
 // https://sldn.softlayer.com/blog/phil/Getting-started-DNS
 type SoftLayer_Dns_Domain_Service interface {
 	Service
 
+	ApplyRecordSync(plan DnsRecordSyncPlan, dryRun bool) (DnsRecordSyncResult, error)
 	CreateObject(template datatypes.SoftLayer_Dns_Domain_Template) (datatypes.SoftLayer_Dns_Domain, error)
+	CreatePtrRecord(ipAddress string, ptrRecord string, ttl int) (datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
 	DeleteObject(dnsId int) (bool, error)
+	ExportZone(dnsId int) (string, error)
+	FindRecords(domainName string, host string, recordType string) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	GetAllResourceRecords(dnsId int, filter DnsResourceRecordFilter) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
 	GetObject(dnsId int) (datatypes.SoftLayer_Dns_Domain, error)
 	GetByDomainName(name string) ([]datatypes.SoftLayer_Dns_Domain, error)
+	GetResourceRecords(dnsId int, filter DnsResourceRecordFilter, offset int, limit int) ([]datatypes.SoftLayer_Dns_Domain_ResourceRecord, error)
+	GetZoneFileContents(dnsId int) (string, error)
+	ImportZone(zoneFile string) (datatypes.SoftLayer_Dns_Domain, error)
+	PlanRecordSync(dnsId int, desired []datatypes.SoftLayer_Dns_Domain_ResourceRecord) (DnsRecordSyncPlan, error)
+	RenderRecordSyncPlan(plan DnsRecordSyncPlan) string
 }
@@ -0,0 +1,18 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+// SoftLayer transfers secondary zones from the master IP address every transferFrequency minutes.
+type SoftLayer_Dns_Secondary_Service interface {
+	Service
+
+	CreateObject(template datatypes.SoftLayer_Dns_Secondary_Template) (datatypes.SoftLayer_Dns_Secondary, error)
+	DeleteObject(secondaryId int) (bool, error)
+	EditObject(secondaryId int, template datatypes.SoftLayer_Dns_Secondary_Template) (bool, error)
+	GetByDomainName(name string) ([]datatypes.SoftLayer_Dns_Secondary, error)
+	GetErrorMessages(secondaryId int) ([]datatypes.SoftLayer_Dns_Message, error)
+	GetObject(secondaryId int) (datatypes.SoftLayer_Dns_Secondary, error)
+	TransferNow(secondaryId int) (bool, error)
+}
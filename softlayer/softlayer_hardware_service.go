@@ -4,11 +4,45 @@ import (
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
+type BareMetalServerOrderOptions struct {
+	Hostname   string
+	Domain     string
+	Datacenter string // Datacenter name, e.g. "dal10"
+
+	PackageId     int    // Id of the hardware server product package
+	PresetKeyName string // Fixed configuration preset, e.g. "S1270_32GB_1X1TB_NONRAID"
+
+	// Key names of the items ordered in addition to the preset, e.g. operating
+	// system, port speed, bandwidth, disks or RAID controller
+	ItemKeyNames []string
+
+	HourlyBilling      bool
+	SshKeyIds          []int
+	ProvisionScriptUri string
+}
+
+type FirmwareUpdateOptions struct {
+	Ipmi           bool
+	RaidController bool
+	Bios           bool
+	HardDrive      bool // Only used by firmware updates, ignored on reflash
+	NetworkCard    bool // Only used by firmware updates, ignored on reflash
+}
+
 type SoftLayer_Hardware_Service interface {
 	Service
 
+	ActivatePrivatePort(id int) (bool, error)
+	ActivatePublicPort(id int) (bool, error)
 	AllowAccessToNetworkStorage(id int, storage datatypes.SoftLayer_Network_Storage) (bool, error)
 
+	BootToRescueLayer(id int, noOsBootEnvironment string) (bool, error)
+	BuildBareMetalServerOrder(options *BareMetalServerOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error)
+
+	CancelObject(id int, options *CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+	ConfirmReloadOperatingSystem(id int, token string, template datatypes.Image_Template_Config) error
+	CreateFirmwareReflashTransaction(id int, options *FirmwareUpdateOptions) (bool, error)
+	CreateFirmwareUpdateTransaction(id int, options *FirmwareUpdateOptions) (bool, error)
 	CreateObject(template datatypes.SoftLayer_Hardware_Template) (datatypes.SoftLayer_Hardware, error)
 
 	FindByIpAddress(ipAddress string) (datatypes.SoftLayer_Hardware, error)
@@ -16,9 +50,21 @@ type SoftLayer_Hardware_Service interface {
 	GetObject(id int) (datatypes.SoftLayer_Hardware, error)
 	GetAllowedHost(id int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
 	GetAttachedNetworkStorages(id int, nasType string) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error)
+	GetComponents(id int) ([]datatypes.SoftLayer_Hardware_Component, error)
 	GetDatacenter(id int) (datatypes.SoftLayer_Location, error)
+	GetHardDrives(id int) ([]datatypes.SoftLayer_Hardware_Component, error)
+	GetNetworkComponents(id int) ([]datatypes.SoftLayer_Network_Component, error)
+	GetNetworkManagementIpAddress(id int) (string, error)
+	GetPendingCancellationRequest(id int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 	GetPrimaryIpAddress(id int) (string, error)
 	GetPrimaryBackendIpAddress(id int) (string, error)
+	GetReloadOperatingSystemToken(id int, template datatypes.Image_Template_Config) (string, error)
+	GetRaidControllers(id int) ([]datatypes.SoftLayer_Hardware_Component, error)
+	GetRemoteManagementAccounts(id int) ([]datatypes.SoftLayer_Hardware_Component_RemoteManagement_User, error)
+	GetSensorData(id int) ([]datatypes.SoftLayer_Container_RemoteManagement_SensorReading, error)
+
+	OrderBareMetalServer(options *BareMetalServerOrderOptions) (datatypes.SoftLayer_Hardware, error)
 
 	PowerOff(instanceId int) (bool, error)
 	PowerOffSoft(instanceId int) (bool, error)
@@ -27,6 +73,14 @@ type SoftLayer_Hardware_Service interface {
 	RebootDefault(instanceId int) (bool, error)
 	RebootSoft(instanceId int) (bool, error)
 	RebootHard(instanceId int) (bool, error)
+	ReloadOperatingSystem(id int, template datatypes.Image_Template_Config) error
+	ReverseCancellation(id int) (bool, error)
 
+	SetPrivateNetworkInterfaceSpeed(id int, speed int) (bool, error)
+	SetPublicNetworkInterfaceSpeed(id int, speed int) (bool, error)
 	SetTags(instanceId int, tags []string) (bool, error)
+	ShutdownPrivatePort(id int) (bool, error)
+	ShutdownPublicPort(id int) (bool, error)
+
+	VerifyBareMetalServerOrder(options *BareMetalServerOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error)
 }
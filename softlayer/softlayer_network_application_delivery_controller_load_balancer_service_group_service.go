@@ -0,0 +1,15 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group_Service interface {
+	Service
+
+	GetGraphImage(id int, graphType string, metric string) ([]byte, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Group, error)
+	GetServices(id int) ([]datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service, error)
+
+	KickAllConnections(id int) (bool, error)
+}
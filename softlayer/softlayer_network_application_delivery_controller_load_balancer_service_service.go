@@ -0,0 +1,19 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service_Service interface {
+	Service
+
+	DeleteObject(id int) (bool, error)
+	Disable(id int) (bool, error)
+
+	Enable(id int) (bool, error)
+
+	GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service, error)
+	GetStatus(id int) ([]datatypes.SoftLayer_Container_Network_LoadBalancer_StatusEntry, error)
+
+	ToggleStatus(id int) (bool, error)
+}
@@ -0,0 +1,18 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress_Service interface {
+	Service
+
+	AddService(id int, virtualServerId int, serviceGroupId int, template datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_Service) (bool, error)
+
+	EditObject(id int, template datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress) (bool, error)
+
+	GetObject(id int) (datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualIpAddress, error)
+	GetVirtualServers(id int) ([]datatypes.SoftLayer_Network_Application_Delivery_Controller_LoadBalancer_VirtualServer, error)
+
+	SetHealthCheck(id int, serviceId int, healthCheckTypeId int) (bool, error)
+}
@@ -0,0 +1,15 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Component_Service interface {
+	Service
+
+	AddNetworkVlanTrunks(id int, vlanIds []int) ([]datatypes.SoftLayer_Network_Vlan, error)
+	ClearNetworkVlanTrunks(id int) (bool, error)
+	GetNetworkVlanTrunks(id int) ([]datatypes.SoftLayer_Network_Component_Network_Vlan_Trunk, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_Component, error)
+	RemoveNetworkVlanTrunks(id int, vlanIds []int) ([]datatypes.SoftLayer_Network_Vlan, error)
+}
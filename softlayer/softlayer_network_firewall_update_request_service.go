@@ -0,0 +1,23 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type FirewallRuleDiff struct {
+	Added   []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule
+	Removed []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule
+}
+
+type SoftLayer_Network_Firewall_Update_Request_Service interface {
+	Service
+
+	CreateObject(template datatypes.SoftLayer_Network_Firewall_Update_Request) (datatypes.SoftLayer_Network_Firewall_Update_Request, error)
+
+	DiffRules(current []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, desired []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) FirewallRuleDiff
+
+	GetObject(id int) (datatypes.SoftLayer_Network_Firewall_Update_Request, error)
+	GetRules(id int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error)
+
+	WaitForUpdateRequestToComplete(id int) error
+}
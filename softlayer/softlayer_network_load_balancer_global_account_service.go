@@ -0,0 +1,17 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_LoadBalancer_Global_Account_Service interface {
+	Service
+
+	AddHost(id int, host datatypes.SoftLayer_Network_LoadBalancer_Global_Host) (bool, error)
+
+	EditObject(id int, template datatypes.SoftLayer_Network_LoadBalancer_Global_Account) (bool, error)
+
+	GetObject(id int) (datatypes.SoftLayer_Network_LoadBalancer_Global_Account, error)
+
+	RemoveHost(hostId int) (bool, error)
+}
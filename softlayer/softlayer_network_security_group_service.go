@@ -0,0 +1,26 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_SecurityGroup_Service interface {
+	Service
+
+	AddRules(id int, rules []datatypes.SoftLayer_Network_SecurityGroup_Rule) (datatypes.SoftLayer_Network_SecurityGroup_RequestRules, error)
+	AttachNetworkComponents(id int, networkComponentIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error)
+
+	CreateObject(template datatypes.SoftLayer_Network_SecurityGroup) (datatypes.SoftLayer_Network_SecurityGroup, error)
+
+	DeleteObject(id int) (bool, error)
+	DetachNetworkComponents(id int, networkComponentIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error)
+
+	EditObject(id int, template datatypes.SoftLayer_Network_SecurityGroup) (bool, error)
+	EditRules(id int, rules []datatypes.SoftLayer_Network_SecurityGroup_Rule) (datatypes.SoftLayer_Network_SecurityGroup_Request, error)
+
+	GetAllObjects() ([]datatypes.SoftLayer_Network_SecurityGroup, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_SecurityGroup, error)
+	GetRules(id int) ([]datatypes.SoftLayer_Network_SecurityGroup_Rule, error)
+
+	RemoveRules(id int, ruleIds []int) (datatypes.SoftLayer_Network_SecurityGroup_Request, error)
+}
@@ -4,19 +4,98 @@ import (
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
+type NetworkStorageOrderOptions struct {
+	Location         string  // Datacenter name, e.g. "dal10"
+	Size             int     // Volume size in GB
+	StorageType      string  // "ENDURANCE" or "PERFORMANCE"
+	Tier             float64 // Endurance only, IOPS per GB: 0.25, 2, 4 or 10
+	Iops             int     // Performance only, provisioned IOPS of the volume
+	OsFormatType     string  // Block storage only, e.g. "LINUX", "VMWARE", "WINDOWS_2008", "XEN" or "HYPER_V", defaults to "LINUX"
+	UseHourlyPricing bool
+}
+
+type NetworkStorageOffering struct {
+	StorageType string  // "ENDURANCE" or "PERFORMANCE"
+	MinimumSize int     // Smallest volume size in GB
+	MaximumSize int     // Largest volume size in GB
+	Tier        float64 // Endurance only, IOPS per GB
+	Iops        int     // Performance only, provisioned IOPS
+}
+
+type SnapshotScheduleOptions struct {
+	ScheduleType   string // "HOURLY", "DAILY" or "WEEKLY"
+	RetentionCount int    // Number of snapshots kept by the schedule
+	Minute         int
+	Hour           int    // Daily and weekly schedules only
+	DayOfWeek      string // Weekly schedules only, e.g. "SUNDAY"
+}
+
+type ReplicaOrderOptions struct {
+	Location         string // Partner datacenter of the replica, e.g. "dal12"
+	ScheduleType     string // Snapshot schedule the replication follows: "HOURLY", "DAILY" or "WEEKLY"
+	UseHourlyPricing bool
+}
+
+type NetworkStorageModifyOptions struct {
+	Size int     // New volume size in GB, volumes can only grow, defaults to the current size
+	Tier float64 // Endurance only, new IOPS per GB, defaults to the current tier
+	Iops int     // Performance only, new provisioned IOPS, defaults to the current IOPS
+}
+
+type NetworkStorageDuplicateOptions struct {
+	SnapshotId       int     // Snapshot of the origin volume to duplicate, defaults to its current content
+	Size             int     // Duplicate size in GB, defaults to the size of the origin volume
+	Tier             float64 // Endurance only, defaults to the tier of the origin volume
+	Iops             int     // Performance only, defaults to the IOPS of the origin volume
+	SnapshotSize     int     // Snapshot space in GB ordered with the duplicate, none when 0
+	UseHourlyPricing bool
+}
+
 type SoftLayer_Network_Storage_Service interface {
 	Service
 
 	DeleteObject(volumeId int) (bool, error)
 
 	CreateNetworkStorage(size int, capacity int, location string, userHourlyPricing bool) (datatypes.SoftLayer_Network_Storage, error)
+	CreateNetworkStorageWithOsFormatType(size int, capacity int, location string, userHourlyPricing bool, osFormatType string) (datatypes.SoftLayer_Network_Storage, error)
 	DeleteNetworkStorage(volumeId int, immediateCancellationFlag bool) error
 	GetNetworkStorage(volumeId int) (datatypes.SoftLayer_Network_Storage, error)
 	GetBillingItem(volumeId int) (datatypes.SoftLayer_Billing_Item, error)
 	HasAllowedVirtualGuest(volumeId int, vmId int) (bool, error)
 	HasAllowedHardware(volumeId int, vmId int) (bool, error)
+	HasAllowedSubnet(volumeId int, subnetId int) (bool, error)
+	HasAllowedIpAddress(volumeId int, ipAddressId int) (bool, error)
 	AttachNetworkStorageToVirtualGuest(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) (bool, error)
 	DetachNetworkStorageFromVirtualGuest(virtualGuest datatypes.SoftLayer_Virtual_Guest, volumeId int) error
 	AttachNetworkStorageToHardware(hardware datatypes.SoftLayer_Hardware, volumeId int) (bool, error)
 	DetachNetworkStorageFromHardware(hardware datatypes.SoftLayer_Hardware, volumeId int) error
+	AttachNetworkStorageToSubnet(subnet datatypes.SoftLayer_Network_Subnet, volumeId int) (bool, error)
+	DetachNetworkStorageFromSubnet(subnet datatypes.SoftLayer_Network_Subnet, volumeId int) error
+	AttachNetworkStorageToIpAddress(ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress, volumeId int) (bool, error)
+	DetachNetworkStorageFromIpAddress(ipAddress datatypes.SoftLayer_Network_Subnet_IpAddress, volumeId int) error
+
+	OrderBlockStorage(options *NetworkStorageOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	OrderFileStorage(options *NetworkStorageOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	GetNetworkStorageOfferings(datacenter string) ([]NetworkStorageOffering, error)
+	GetFileNetworkMountAddress(volumeId int) (string, error)
+
+	OrderSnapshotSpace(volumeId int, size int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	CreateSnapshot(volumeId int, notes string) (datatypes.SoftLayer_Network_Storage, error)
+	GetSnapshots(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error)
+	DeleteSnapshot(snapshotId int) (bool, error)
+	RestoreFromSnapshot(volumeId int, snapshotId int) (bool, error)
+	EnableSnapshots(volumeId int, options *SnapshotScheduleOptions) (bool, error)
+	DisableSnapshots(volumeId int, scheduleType string) (bool, error)
+	GetSnapshotSchedules(volumeId int) ([]datatypes.SoftLayer_Network_Storage_Schedule, error)
+
+	OrderReplicaVolume(volumeId int, options *ReplicaOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	GetReplicantVolumes(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error)
+	GetReplicationPartners(volumeId int) ([]datatypes.SoftLayer_Network_Storage, error)
+	FailoverToReplicant(volumeId int, replicantId int, immediate bool) (bool, error)
+	FailbackFromReplicant(volumeId int, replicantId int) (bool, error)
+	GetActiveTransactions(volumeId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	WaitForReplication(volumeId int) error
+
+	OrderModifiedVolume(volumeId int, options *NetworkStorageModifyOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	OrderDuplicateVolume(volumeId int, options *NetworkStorageDuplicateOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 }
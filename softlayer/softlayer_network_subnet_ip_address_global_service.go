@@ -0,0 +1,17 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Subnet_IpAddress_Global_Service interface {
+	Service
+
+	GetObject(id int) (datatypes.SoftLayer_Network_Subnet_IpAddress_Global, error)
+
+	OrderGlobalIp(ipv6 bool) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+
+	Route(id int, destinationIpAddress string) (bool, error)
+
+	Unroute(id int) (bool, error)
+}
@@ -0,0 +1,14 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Subnet_IpAddress_Service interface {
+	Service
+
+	GetByIpAddress(ipAddress string) (datatypes.SoftLayer_Network_Subnet_IpAddress, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_Subnet_IpAddress, error)
+
+	SetNote(id int, note string) (bool, error)
+}
@@ -0,0 +1,29 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SubnetOrderOptions struct {
+	Type     string // "PORTABLE" or "STATIC"
+	Public   bool   // Orders public addresses, private ones otherwise
+	Capacity int    // Number of IP addresses, e.g. 4, 8, 16 or 32
+
+	VlanId              int // Portable subnets only, VLAN the subnet is placed on
+	EndPointIpAddressId int // Static subnets only, IP address the subnet is routed to
+}
+
+type SoftLayer_Network_Subnet_Service interface {
+	Service
+
+	ClearRoute(id int) (bool, error)
+
+	GetFreeIpAddresses(id int) ([]datatypes.SoftLayer_Network_Subnet_IpAddress, error)
+	GetIpAddresses(id int) ([]datatypes.SoftLayer_Network_Subnet_IpAddress, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_Subnet, error)
+	GetReverseDomainRecords(id int) ([]datatypes.SoftLayer_Dns_Domain, error)
+
+	OrderSubnet(options *SubnetOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+
+	Route(id int, endPointType string, endPointIdentifier string) (bool, error)
+}
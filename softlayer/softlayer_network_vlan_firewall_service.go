@@ -0,0 +1,17 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type SoftLayer_Network_Vlan_Firewall_Service interface {
+	Service
+
+	Bypass(id int, context string, direction string) (datatypes.SoftLayer_Network_Firewall_Update_Request, error)
+
+	GetObject(id int) (datatypes.SoftLayer_Network_Vlan_Firewall, error)
+	GetRules(id int) ([]datatypes.SoftLayer_Network_Firewall_Update_Request_Rule, error)
+
+	Unbypass(id int, context string, direction string) (datatypes.SoftLayer_Network_Firewall_Update_Request, error)
+	UpdateRules(id int, context string, direction string, rules []datatypes.SoftLayer_Network_Firewall_Update_Request_Rule) (datatypes.SoftLayer_Network_Firewall_Update_Request, error)
+}
@@ -0,0 +1,26 @@
+package softlayer
+
+import (
+	datatypes "github.com/maximilien/softlayer-go/data_types"
+)
+
+type NetworkVlanOrderOptions struct {
+	Datacenter string // Datacenter name, e.g. "dal10"
+	Public     bool   // Orders a public VLAN, a private one otherwise
+	RouterId   int    // Optional, id of the router the VLAN is placed behind
+	Name       string
+}
+
+type SoftLayer_Network_Vlan_Service interface {
+	Service
+
+	CancelObject(id int, options *CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
+
+	EditObject(id int, template datatypes.SoftLayer_Network_Vlan_Template) (bool, error)
+
+	GetBillingItem(id int) (datatypes.SoftLayer_Billing_Item, error)
+	GetFirewallInterfaces(id int) ([]datatypes.SoftLayer_Network_Firewall_Interface, error)
+	GetObject(id int) (datatypes.SoftLayer_Network_Vlan, error)
+
+	OrderVlan(options *NetworkVlanOrderOptions) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+}
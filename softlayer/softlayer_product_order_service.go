@@ -9,5 +9,14 @@ type SoftLayer_Product_Order_Service interface {
 
 	PlaceOrder(order datatypes.SoftLayer_Container_Product_Order) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 	PlaceContainerOrderNetworkPerformanceStorageIscsi(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Iscsi) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkPerformanceStorageNfs(order datatypes.SoftLayer_Container_Product_Order_Network_PerformanceStorage_Nfs) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkStorageAsAService(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkStorageAsAServiceUpgrade(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_AsAService_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkStorageEnterpriseSnapshotSpace(order datatypes.SoftLayer_Container_Product_Order_Network_Storage_Enterprise_SnapshotSpace) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 	PlaceContainerOrderVirtualGuestUpgrade(order datatypes.SoftLayer_Container_Product_Order_Virtual_Guest_Upgrade) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkVlan(order datatypes.SoftLayer_Container_Product_Order_Network_Vlan) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderNetworkSubnet(order datatypes.SoftLayer_Container_Product_Order_Network_Subnet) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+	PlaceContainerOrderHardwareServer(order datatypes.SoftLayer_Container_Product_Order_Hardware_Server) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
+
+	VerifyContainerOrderHardwareServer(order datatypes.SoftLayer_Container_Product_Order_Hardware_Server) (datatypes.SoftLayer_Container_Product_Order_Hardware_Server, error)
 }
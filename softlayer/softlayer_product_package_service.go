@@ -7,6 +7,10 @@ import (
 type SoftLayer_Product_Package_Service interface {
 	Service
 
+	GetActivePresets(packageId int) ([]datatypes.SoftLayer_Product_Package_Preset, error)
+
+	GetRegions(packageId int) ([]datatypes.SoftLayer_Location_Region, error)
+
 	GetItemPrices(packageId int, filters string) ([]datatypes.SoftLayer_Product_Item_Price, error)
 	GetItems(packageId int, filters string) ([]datatypes.SoftLayer_Product_Item, error)
 	GetItemsByType(packageType string) ([]datatypes.SoftLayer_Product_Item, error)
@@ -1,13 +1,23 @@
 package softlayer
 
 import (
+	"time"
+
 	datatypes "github.com/maximilien/softlayer-go/data_types"
 )
 
 type UpgradeOptions struct {
-	Cpus       int
-	MemoryInGB int // Softlayer allows to upgrade Memory only in GB
-	NicSpeed   int
+	Cpus            int
+	MemoryInGB      int // Softlayer allows to upgrade Memory only in GB
+	NicSpeed        int
+	PublicBandwidth int  // Public bandwidth allotment in GB
+	DedicatedCores  bool // Use private (dedicated) cores prices for Cpus
+
+	// Disk capacities in GB keyed by disk number, e.g. 2 stands for guest_disk2
+	Disks map[int]int
+
+	// Time to apply the order at, the order is applied immediately when not set
+	MaintenanceWindow time.Time
 }
 
 type SoftLayer_Virtual_Guest_Service interface {
@@ -18,16 +28,20 @@ type SoftLayer_Virtual_Guest_Service interface {
 	AttachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	AttachEphemeralDisk(instanceId int, diskSize int) (datatypes.SoftLayer_Container_Product_Order_Receipt, error)
 
+	CancelObject(instanceId int, options *CancellationOptions) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 	CaptureImage(instanceId int) (datatypes.SoftLayer_Container_Disk_Image_Capture_Template, error)
 	CheckHostDiskAvailability(instanceId int, diskCapacity int) (bool, error)
+	ConfirmReloadOperatingSystem(instanceId int, token string, template datatypes.Image_Template_Config) error
 	ConfigureMetadataDisk(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	CreateArchiveTransaction(instanceId int, groupName string, blockDevices []datatypes.SoftLayer_Virtual_Guest_Block_Device, note string) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	CreateObject(template datatypes.SoftLayer_Virtual_Guest_Template) (datatypes.SoftLayer_Virtual_Guest, error)
 
 	DeleteObject(instanceId int) (bool, error)
 	DetachDiskImage(instanceId int, imageId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	DowngradeObject(instanceId int, upgradeOptions *UpgradeOptions) (bool, error)
 
 	EditObject(instanceId int, template datatypes.SoftLayer_Virtual_Guest) (bool, error)
+	ExecuteRescueLayer(instanceId int) (bool, error)
 
 	IsPingable(instanceId int) (bool, error)
 	IsBackendPingable(instanceId int) (bool, error)
@@ -36,24 +50,39 @@ type SoftLayer_Virtual_Guest_Service interface {
 	GetLastTransaction(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetActiveTransactions(instanceId int) ([]datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
 	GetAllowedHost(instanceId int) (datatypes.SoftLayer_Network_Storage_Allowed_Host, error)
-	GetLocalDiskFlag(instanceId int) (bool, error)
+	GetBandwidthDataByDate(instanceId int, startDate time.Time, endDate time.Time, networkType string) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error)
+	GetBillingCycleBandwidthUsage(instanceId int) ([]datatypes.SoftLayer_Network_Bandwidth_Usage, error)
+	GetBillingItem(instanceId int) (datatypes.SoftLayer_Billing_Item, error)
 	GetBlockDevices(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Block_Device, error)
+	GetBootOrder(instanceId int) (string, error)
+	GetConsoleData(instanceId int) (datatypes.SoftLayer_Container_Virtual_ConsoleData, error)
+	GetCpuMetricDataByDate(instanceId int, startDate time.Time, endDate time.Time, cpuIndexes []int) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error)
+	GetInboundPublicBandwidthUsage(instanceId int) (float64, error)
+	GetLocalDiskFlag(instanceId int) (bool, error)
+	GetMemoryMetricDataByDate(instanceId int, startDate time.Time, endDate time.Time) ([]datatypes.SoftLayer_Metric_Tracking_Object_Data, error)
 	GetNetworkComponents(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
 	GetNetworkVlans(instanceId int) ([]datatypes.SoftLayer_Network_Vlan, error)
 	GetObject(instanceId int) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetObjectByPrimaryIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
 	GetObjectByPrimaryBackendIpAddress(ipAddress string) (datatypes.SoftLayer_Virtual_Guest, error)
+	GetOutboundPublicBandwidthUsage(instanceId int) (float64, error)
 	GetPrimaryIpAddress(instanceId int) (string, error)
 	GetPrimaryBackendIpAddress(instanceId int) (string, error)
 	GetPrimaryBackendNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
 	GetPrimaryNetworkComponent(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Network_Component, error)
+	GetReloadOperatingSystemToken(instanceId int, template datatypes.Image_Template_Config) (string, error)
+	GetPendingCancellationRequest(instanceId int) (datatypes.SoftLayer_Billing_Item_Cancellation_Request, error)
 	GetPowerState(instanceId int) (datatypes.SoftLayer_Virtual_Guest_Power_State, error)
 	GetSshKeys(instanceId int) ([]datatypes.SoftLayer_Security_Ssh_Key, error)
 	GetTagReferences(instanceId int) ([]datatypes.SoftLayer_Tag_Reference, error)
 	GetUpgradeItemPrices(instanceId int) ([]datatypes.SoftLayer_Product_Item_Price, error)
 	GetUserData(instanceId int) ([]datatypes.SoftLayer_Virtual_Guest_Attribute, error)
-	GetAvailableUpgradeItemPrices(upgradeOptions *UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error)
+	GetAvailableUpgradeItemPrices(instanceId int, upgradeOptions *UpgradeOptions) ([]datatypes.SoftLayer_Product_Item_Price, error)
+
+	Migrate(instanceId int) (datatypes.SoftLayer_Provisioning_Version1_Transaction, error)
+	MigrateDedicatedHost(instanceId int, destinationHostId int) error
 
+	Pause(instanceId int) (bool, error)
 	PowerCycle(instanceId int) (bool, error)
 	PowerOff(instanceId int) (bool, error)
 	PowerOffSoft(instanceId int) (bool, error)
@@ -62,7 +91,10 @@ type SoftLayer_Virtual_Guest_Service interface {
 	RebootDefault(instanceId int) (bool, error)
 	RebootSoft(instanceId int) (bool, error)
 	RebootHard(instanceId int) (bool, error)
+	ReverseCancellation(instanceId int) (bool, error)
+	Resume(instanceId int) (bool, error)
 
+	SetBootOrder(instanceId int, bootOrder string) (bool, error)
 	SetMetadata(instanceId int, metadata string) (bool, error)
 	SetTags(instanceId int, tags []string) (bool, error)
 	ShutdownPrivatePort(instanceId int) (bool, error)
@@ -70,4 +102,6 @@ type SoftLayer_Virtual_Guest_Service interface {
 	ReloadOperatingSystem(instanceId int, template datatypes.Image_Template_Config) error
 
 	UpgradeObject(instanceId int, upgradeOptions *UpgradeOptions) (bool, error)
+
+	WaitForReloadOperatingSystem(instanceId int) error
 }